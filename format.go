@@ -5,21 +5,32 @@ import (
 	"fmt"
 )
 
+// Format formats every statement in sql. Statements are separated by a blank line.
 func Format(sql string) (string, error) {
 	var b bytes.Buffer
 	p := NewParser(sql)
 
-	l, err := p.DirectSQLStatement()
-	if err != nil {
-		return "", err
-	}
+	for {
+		l, err := p.DirectSQLStatement()
+		if err != nil {
+			return "", err
+		}
 
-	if err := l.Write(&b, 0); err != nil {
-		return "", err
-	}
+		if err := l.Write(&b, 0); err != nil {
+			return "", err
+		}
+
+		if _, err := fmt.Fprintln(&b); err != nil {
+			return "", err
+		}
+
+		if _, err := p.expect(eos); err == nil {
+			break
+		}
 
-	if _, err := fmt.Fprintln(&b); err != nil {
-		return "", err
+		if _, err := fmt.Fprintln(&b); err != nil {
+			return "", err
+		}
 	}
 
 	return b.String(), nil
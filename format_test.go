@@ -0,0 +1,65 @@
+package sqlfmt
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFormat(t *testing.T) {
+	t.Run("multiple statements", func(t *testing.T) {
+		s, err := Format(`begin; update t set x = 1 where y = 2; commit;`)
+		assert.NoError(t, err)
+		assert.Equal(t, `BEGIN;
+
+UPDATE t
+   SET x = 1
+ WHERE y = 2;
+
+COMMIT;
+`, s)
+	})
+
+	t.Run("deeply nested parentheses", func(t *testing.T) {
+		n := 32
+		s, err := Format(`SELECT xx FROM t WHERE ` + strings.Repeat("(", n) + `xx = 1` + strings.Repeat(")", n) + `;`)
+		assert.NoError(t, err)
+		assert.Equal(t, `SELECT xx
+  FROM t
+ WHERE `+strings.Repeat("(", n)+`xx = 1`+strings.Repeat(")", n)+`;
+`, s)
+	})
+
+	t.Run("deeply nested function calls", func(t *testing.T) {
+		n := 32
+		_, err := Format(`SELECT ` + strings.Repeat("myfn(", n) + `x` + strings.Repeat(", 1)", n) + `, ` + strings.Repeat("SUM(", n) + `x` + strings.Repeat(")", n) + ` FROM t;`)
+		assert.NoError(t, err)
+	})
+
+	t.Run("nested case", func(t *testing.T) {
+		s, err := Format(`SELECT ` + strings.Repeat("CASE WHEN xx = 1 THEN ", 3) + `1` + strings.Repeat(" ELSE 0 END", 3) + ` FROM t;`)
+		assert.NoError(t, err)
+		assert.Equal(t, `SELECT CASE WHEN xx = 1 THEN CASE WHEN xx = 1 THEN CASE WHEN xx = 1 THEN 1
+                                                        ELSE 0
+                                                   END
+                                  ELSE 0
+                             END
+            ELSE 0
+       END
+  FROM t;
+`, s)
+
+		n := 32
+		_, err = Format(`SELECT ` + strings.Repeat("CASE WHEN xx = 1 THEN ", n) + `1` + strings.Repeat(" ELSE 0 END", n) + ` FROM t;`)
+		assert.NoError(t, err)
+	})
+
+	t.Run("malformed alter default privileges", func(t *testing.T) {
+		_, err := Format(`ALTER DEFAULT PRIVILEGES;`)
+		assert.Error(t, err)
+
+		_, err = Format(`ALTER DEFAULT PRIVILEGES IN SCHEMA s GRANT SELECT ON TABLES TO;`)
+		assert.Error(t, err)
+	})
+}
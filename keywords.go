@@ -59,6 +59,7 @@ var keywords = map[string]struct{}{
 	"BROWSE":                           {},
 	"BULK":                             {},
 	"BY":                               {},
+	"BYPASSRLS":                        {},
 	"C":                                {},
 	"CACHE":                            {},
 	"CALL":                             {},
@@ -112,6 +113,7 @@ var keywords = map[string]struct{}{
 	"COMPLETION":                       {},
 	"COMPRESS":                         {},
 	"COMPUTE":                          {},
+	"CONCURRENTLY":                     {},
 	"CONDITION":                        {},
 	"CONDITION_NUMBER":                 {},
 	"CONNECT":                          {},
@@ -275,6 +277,7 @@ var keywords = map[string]struct{}{
 	"FULL":                             {},
 	"FULLTEXT":                         {},
 	"FUNCTION":                         {},
+	"FUNCTIONS":                        {},
 	"FUSION":                           {},
 	"G":                                {},
 	"GENERAL":                          {},
@@ -398,6 +401,7 @@ var keywords = map[string]struct{}{
 	"MAP":                              {},
 	"MATCH":                            {},
 	"MATCHED":                          {},
+	"MATERIALIZED":                     {},
 	"MAX":                              {},
 	"MAX_ROWS":                         {},
 	"MAXEXTENTS":                       {},
@@ -442,6 +446,8 @@ var keywords = map[string]struct{}{
 	"NEW":                              {},
 	"NEXT":                             {},
 	"NO":                               {},
+	"NOBYPASSRLS":                      {},
+	"NOREPLICATION":                    {},
 	"NO_WRITE_TO_BINLOG":               {},
 	"NOAUDIT":                          {},
 	"NOCHECK":                          {},
@@ -504,6 +510,7 @@ var keywords = map[string]struct{}{
 	"OVERLAPS":                         {},
 	"OVERLAY":                          {},
 	"OVERRIDING":                       {},
+	"OWNED":                            {},
 	"OWNER":                            {},
 	"PACK_KEYS":                        {},
 	"PAD":                              {},
@@ -605,6 +612,7 @@ var keywords = map[string]struct{}{
 	"ROLLBACK":                         {},
 	"ROLLUP":                           {},
 	"ROUTINE":                          {},
+	"ROUTINES":                         {},
 	"ROUTINE_CATALOG":                  {},
 	"ROUTINE_NAME":                     {},
 	"ROUTINE_SCHEMA":                   {},
@@ -638,6 +646,7 @@ var keywords = map[string]struct{}{
 	"SENSITIVE":                        {},
 	"SEPARATOR":                        {},
 	"SEQUENCE":                         {},
+	"SEQUENCES":                        {},
 	"SERIALIZABLE":                     {},
 	"SERVER_NAME":                      {},
 	"SESSION":                          {},
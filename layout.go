@@ -1,6 +1,7 @@
 package sqlfmt
 
 import (
+	"bytes"
 	"fmt"
 	"io"
 	"strings"
@@ -99,6 +100,13 @@ func gutterAligned(l Layout, g int) Layout {
 		}
 		r[0] = gutterAligned(r[0], g-d)
 		return r
+	case Choice:
+		return Choice{
+			One:     gutterAligned(l.One, g),
+			Another: gutterAligned(l.Another, g),
+		}
+	case Indent:
+		return l
 	default:
 		panic(l)
 	}
@@ -124,17 +132,16 @@ func (s Stack) Strip() Layout {
 type Juxtaposition []Layout
 
 func (j Juxtaposition) Write(w io.Writer, indent int) error {
+	cw := columnWriter{Writer: w, column: indent}
 	for i, l := range j {
 		if i != 0 {
-			if _, err := fmt.Fprint(w, " "); err != nil {
+			if _, err := fmt.Fprint(&cw, " "); err != nil {
 				return err
 			}
-			indent++
 		}
-		if err := l.Write(w, indent); err != nil {
+		if err := l.Write(&cw, cw.column); err != nil {
 			return err
 		}
-		indent += l.Offset()
 	}
 	return nil
 }
@@ -174,11 +181,11 @@ func (j Juxtaposition) Strip() Layout {
 type Concatenation []Layout
 
 func (c Concatenation) Write(w io.Writer, indent int) error {
+	cw := columnWriter{Writer: w, column: indent}
 	for _, l := range c {
-		if err := l.Write(w, indent); err != nil {
+		if err := l.Write(&cw, cw.column); err != nil {
 			return err
 		}
-		indent += l.Offset()
 	}
 	return nil
 }
@@ -221,7 +228,155 @@ func (c Concatenation) Strip() Layout {
 	return c
 }
 
+// Choice is written as One if it fits in the width, otherwise as Another.
 type Choice struct {
 	One     Layout
 	Another Layout
 }
+
+// width is the maximum number of columns Choice tries to fit One in.
+const width = 80
+
+func (c Choice) Write(w io.Writer, indent int) error {
+	// in a trial, nested choices take One so that they aren't written both ways at every level.
+	if inTrial(w) {
+		return c.One.Write(w, indent)
+	}
+
+	var b trial
+	if err := c.One.Write(&b, indent); err != nil {
+		return err
+	}
+
+	if fits(b.String(), indent) {
+		_, err := b.WriteTo(w)
+		return err
+	}
+
+	return c.Another.Write(w, indent)
+}
+
+// trial is where Choice writes One to see if it fits.
+type trial struct {
+	bytes.Buffer
+}
+
+// inTrial tells if w eventually writes to a trial.
+func inTrial(w io.Writer) bool {
+	for {
+		switch v := w.(type) {
+		case *trial:
+			return true
+		case *columnWriter:
+			w = v.Writer
+		default:
+			return false
+		}
+	}
+}
+
+func fits(s string, indent int) bool {
+	for _, l := range strings.Split(s, "\n") {
+		if indent+len(l) > width {
+			return false
+		}
+		indent = 0
+	}
+	return true
+}
+
+// flatWidth returns the number of columns l takes up and whether it's written in a single line.
+func flatWidth(l Layout) (int, bool) {
+	var b bytes.Buffer
+	if err := l.Write(&b, 0); err != nil {
+		return 0, false
+	}
+	if strings.Contains(b.String(), "\n") {
+		return 0, false
+	}
+	return b.Len(), true
+}
+
+// alignColumns pads the elements of js so that they line up in columns.
+// The last element of each Juxtaposition is left as is since nothing follows it.
+// It fails if any of the padded elements spans multiple lines.
+func alignColumns(js []Juxtaposition) ([]Juxtaposition, bool) {
+	var widths []int
+	for _, j := range js {
+		for i, e := range j[:len(j)-1] {
+			w, ok := flatWidth(e)
+			if !ok {
+				return nil, false
+			}
+			if i == len(widths) {
+				widths = append(widths, 0)
+			}
+			if w > widths[i] {
+				widths[i] = w
+			}
+		}
+	}
+
+	as := make([]Juxtaposition, len(js))
+	for k, j := range js {
+		a := make(Juxtaposition, len(j))
+		for i, e := range j {
+			if i < len(j)-1 {
+				if w, _ := flatWidth(e); w < widths[i] {
+					e = Concatenation{e, Atom(strings.Repeat(" ", widths[i]-w))}
+				}
+			}
+			a[i] = e
+		}
+		as[k] = a
+	}
+	return as, true
+}
+
+func (c Choice) Offset() int {
+	return c.One.Offset()
+}
+
+func (c Choice) Gutter() int {
+	return c.One.Gutter()
+}
+
+// Indent is written as Layout shifted indentWidth columns to the right of the lines around it.
+// It stays out of the river so that a block nested under a header keeps its own alignment.
+type Indent struct {
+	Layout Layout
+}
+
+// indentWidth is the number of columns Indent shifts Layout by.
+const indentWidth = 4
+
+func (i Indent) Write(w io.Writer, indent int) error {
+	if _, err := fmt.Fprint(w, strings.Repeat(" ", indentWidth)); err != nil {
+		return err
+	}
+	return i.Layout.Write(w, indent+indentWidth)
+}
+
+func (i Indent) Offset() int {
+	return indentWidth + i.Layout.Offset()
+}
+
+func (i Indent) Gutter() int {
+	return 0
+}
+
+// columnWriter keeps track of the column where the next write starts.
+type columnWriter struct {
+	io.Writer
+	column int
+}
+
+func (c *columnWriter) Write(p []byte) (int, error) {
+	n, err := c.Writer.Write(p)
+	if i := bytes.LastIndexByte(p[:n], '\n'); i >= 0 {
+		c.column = n - i - 1
+	} else {
+		c.column += n
+	}
+	return n, err
+}
@@ -2,6 +2,7 @@ package sqlfmt
 
 import (
 	"bytes"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -61,4 +62,44 @@ consectetur adipiscing elit Aliquam erat volutpat
 		assert.Equal(t, `if (voltage[t] < LOW_THRESHOLD)
     LogLowVoltage(voltage[t])`, b.String())
 	})
+
+	t.Run("choice", func(t *testing.T) {
+		l := Choice{
+			One: Juxtaposition{
+				Atom("Lorem ipsum dolor"),
+				Atom("consectetur adipiscing elit"),
+			},
+			Another: Stack{
+				Atom("Lorem ipsum dolor"),
+				Atom("consectetur adipiscing elit"),
+			},
+		}
+
+		var b bytes.Buffer
+		assert.NoError(t, l.Write(&b, 0))
+		assert.Equal(t, `Lorem ipsum dolor consectetur adipiscing elit`, b.String())
+
+		b.Reset()
+		assert.NoError(t, Juxtaposition{Atom(strings.Repeat(" ", 40)), l}.Write(&b, 0))
+		assert.Equal(t, strings.Repeat(" ", 40)+` Lorem ipsum dolor
+                                         consectetur adipiscing elit`, b.String())
+	})
+
+	t.Run("indent", func(t *testing.T) {
+		l := Stack{
+			Atom("Lorem ipsum dolor"),
+			Indent{Stack{
+				Atom("consectetur adipiscing elit"),
+				Atom("Aliquam erat volutpat"),
+			}},
+			Atom("condimentum vitae leo sit"),
+		}
+
+		var b bytes.Buffer
+		assert.NoError(t, Juxtaposition{Atom("-"), l}.Write(&b, 0))
+		assert.Equal(t, `- Lorem ipsum dolor
+      consectetur adipiscing elit
+      Aliquam erat volutpat
+  condimentum vitae leo sit`, b.String())
+	})
 }
@@ -88,6 +88,7 @@ const (
 	comma
 	minusSign
 	period
+	solidus
 	semicolon
 	lessThanOperator
 	equalsOperator
@@ -125,6 +126,8 @@ func (t tokenType) String() string {
 		return "minus sign"
 	case period:
 		return "period"
+	case solidus:
+		return "solidus"
 	case semicolon:
 		return "semicolon"
 	case lessThanOperator:
@@ -201,6 +204,8 @@ func (l *Lexer) unsignedNumericLiteral(start int) state {
 			return l.unsignedNumericLiteral(start)
 		case r == '.':
 			return l.unsignedFloatLiteral(start)
+		case r == 'E', r == 'e':
+			return l.exponent(start)
 		default:
 			l.backup()
 			l.emit(token{
@@ -217,6 +222,50 @@ func (l *Lexer) unsignedFloatLiteral(start int) state {
 		switch {
 		case unicode.IsDigit(r):
 			return l.unsignedFloatLiteral(start)
+		case r == 'E', r == 'e':
+			return l.exponent(start)
+		default:
+			l.backup()
+			l.emit(token{
+				typ: unsignedNumeric,
+				val: l.input[start:pos],
+			})
+			return l.start()
+		}
+	}
+}
+
+func (l *Lexer) exponent(start int) state {
+	return func(r rune, pos int) state {
+		switch {
+		case r == '+', r == '-':
+			return l.exponentSign(start)
+		case unicode.IsDigit(r):
+			return l.exponentDigits(start)
+		default:
+			l.emit(token{typ: errToken})
+			return nil
+		}
+	}
+}
+
+func (l *Lexer) exponentSign(start int) state {
+	return func(r rune, pos int) state {
+		switch {
+		case unicode.IsDigit(r):
+			return l.exponentDigits(start)
+		default:
+			l.emit(token{typ: errToken})
+			return nil
+		}
+	}
+}
+
+func (l *Lexer) exponentDigits(start int) state {
+	return func(r rune, pos int) state {
+		switch {
+		case unicode.IsDigit(r):
+			return l.exponentDigits(start)
 		default:
 			l.backup()
 			l.emit(token{
@@ -278,8 +327,14 @@ func (l *Lexer) specialChar() state {
 			l.emit(token{typ: minusSign, val: v})
 			return l.start()
 		case '.':
+			if unicode.IsDigit(l.peek()) {
+				return l.unsignedFloatLiteral(pos)
+			}
 			l.emit(token{typ: period, val: v})
 			return l.start()
+		case '/':
+			l.emit(token{typ: solidus, val: v})
+			return l.start()
 		case ';':
 			l.emit(token{typ: semicolon, val: v})
 			return l.start()
@@ -102,4 +102,40 @@ values(10, 'ACCOUNTING', 'NEW YORK');
 		assert.Equal(token{typ: semicolon, val: ";"}, l.Next())
 		assert.Equal(token{typ: eos}, l.Next())
 	})
+
+	t.Run("arithmetic", func(t *testing.T) {
+		assert := assert.New(t)
+
+		l := NewLexer(`-x * (y + z) / w`)
+		assert.Equal(token{typ: minusSign, val: "-"}, l.Next())
+		assert.Equal(token{typ: identifier, val: "x"}, l.Next())
+		assert.Equal(token{typ: asterisk, val: "*"}, l.Next())
+		assert.Equal(token{typ: leftParen, val: "("}, l.Next())
+		assert.Equal(token{typ: identifier, val: "y"}, l.Next())
+		assert.Equal(token{typ: plusSign, val: "+"}, l.Next())
+		assert.Equal(token{typ: identifier, val: "z"}, l.Next())
+		assert.Equal(token{typ: rightParen, val: ")"}, l.Next())
+		assert.Equal(token{typ: solidus, val: "/"}, l.Next())
+		assert.Equal(token{typ: identifier, val: "w"}, l.Next())
+		assert.Equal(token{typ: eos}, l.Next())
+	})
+
+	t.Run("numeric literals", func(t *testing.T) {
+		assert := assert.New(t)
+
+		l := NewLexer(`10 1.5 .5 6.02E23 1e-3`)
+		assert.Equal(token{typ: unsignedNumeric, val: "10"}, l.Next())
+		assert.Equal(token{typ: unsignedNumeric, val: "1.5"}, l.Next())
+		assert.Equal(token{typ: unsignedNumeric, val: ".5"}, l.Next())
+		assert.Equal(token{typ: unsignedNumeric, val: "6.02E23"}, l.Next())
+		assert.Equal(token{typ: unsignedNumeric, val: "1e-3"}, l.Next())
+		assert.Equal(token{typ: eos}, l.Next())
+	})
+
+	t.Run("exponent without digits", func(t *testing.T) {
+		assert := assert.New(t)
+
+		l := NewLexer(`1e+ 2`)
+		assert.Equal(token{typ: errToken}, l.Next())
+	})
 }
@@ -7,11 +7,16 @@ import (
 type Parser struct {
 	lexer   *Lexer
 	current token
+
+	// tokens holds every token read so far so that the parser can backtrack.
+	tokens []token
+	pos    int
 }
 
 func NewParser(input string) *Parser {
 	p := Parser{lexer: NewLexer(input)}
 	p.current = p.lexer.Next()
+	p.tokens = append(p.tokens, p.current)
 	return &p
 }
 
@@ -31,80 +36,195 @@ func (p *Parser) DirectSQLStatement() (Layout, error) {
 }
 
 func (p *Parser) directlyExecutableStatement() (Layout, error) {
-	return p.cursorSpecification()
+	if _, err := p.expect(keyword, "CREATE", "ALTER", "DROP", "GRANT", "REVOKE"); err == nil {
+		return p.sqlSchemaStatement()
+	}
+	if _, err := p.expect(keyword, "START", "BEGIN", "COMMIT", "ROLLBACK", "SAVEPOINT", "RELEASE"); err == nil {
+		return p.sqlTransactionStatement()
+	}
+	if _, err := p.expect(keyword, "SET", "SHOW", "RESET"); err == nil {
+		return p.sqlSessionStatement()
+	}
+	return p.directSQLDataStatement()
 }
 
-func (p *Parser) cursorSpecification() (Layout, error) {
-	return p.queryExpression()
+func (p *Parser) sqlSchemaStatement() (Layout, error) {
+	if _, err := p.expect(keyword, "CREATE", "GRANT"); err == nil {
+		return p.sqlSchemaDefinitionStatement()
+	}
+	return p.sqlSchemaManipulationStatement()
 }
 
-func (p *Parser) queryExpression() (Layout, error) {
-	return p.queryExpressionBody()
+func (p *Parser) sqlSchemaDefinitionStatement() (Layout, error) {
+	if _, err := p.expect(keyword, "GRANT"); err == nil {
+		return p.grantStatement()
+	}
+	if d, err := p.try(p.roleDefinition); err == nil {
+		return d, nil
+	}
+	if d, err := p.try(p.viewDefinition); err == nil {
+		return d, nil
+	}
+	if d, err := p.try(p.indexDefinition); err == nil {
+		return d, nil
+	}
+	if d, err := p.try(p.sequenceGeneratorDefinition); err == nil {
+		return d, nil
+	}
+	if d, err := p.try(p.schemaDefinition); err == nil {
+		return d, nil
+	}
+	return p.tableDefinition()
 }
 
-func (p *Parser) queryExpressionBody() (Layout, error) {
-	s := Stack{}
+func (p *Parser) schemaDefinition() (Layout, error) {
+	j := Juxtaposition{}
 
-	q, err := p.queryTerm()
+	v, err := p.accept(keyword, "CREATE")
 	if err != nil {
-		return nil, fmt.Errorf("query term: %w", err)
+		return nil, err
 	}
-	s.Append(q)
+	j.Append(v)
 
-	for {
-		j := Juxtaposition{}
+	v, err = p.accept(keyword, "SCHEMA")
+	if err != nil {
+		return nil, err
+	}
+	j.Append(v)
 
-		k, err := p.accept(keyword, "UNION", "EXCEPT")
-		if err != nil {
-			break
-		}
-		j.Append(k)
+	if e, err := p.ifNotExists(); err == nil {
+		j.Append(e)
+	}
 
-		if k, err := p.accept(keyword, "ALL", "DISTINCT"); err == nil {
-			j.Append(k)
-		}
+	c, err := p.schemaNameClause()
+	if err != nil {
+		return nil, fmt.Errorf("schema name clause: %w", err)
+	}
+	j.Append(c)
 
-		if c, err := p.correspondingSpec(); err == nil {
-			j.Append(c)
-		}
+	return j, nil
+}
 
-		t, err := p.queryTerm()
+func (p *Parser) schemaNameClause() (Layout, error) {
+	j := Juxtaposition{}
+
+	if n, err := p.schemaName(); err == nil {
+		j.Append(n)
+	}
+
+	if v, err := p.accept(keyword, "AUTHORIZATION"); err == nil {
+		j.Append(v)
+
+		a, err := p.authorizationIdentifier()
 		if err != nil {
-			return nil, err
+			return nil, fmt.Errorf("schema authorization identifier: %w", err)
 		}
+		j.Append(a)
+	}
 
-		s.Append(j, t)
+	if len(j) == 0 {
+		return nil, &ErrUnexpected{
+			ExpectedType: identifier,
+			Actual:       p.current,
+		}
 	}
 
-	s.AlignGutter()
+	return j, nil
+}
 
-	return s, nil
+// schemaName also accepts PUBLIC since the default schema is named after the keyword.
+func (p *Parser) schemaName() (Layout, error) {
+	if v, err := p.accept(keyword, "PUBLIC"); err == nil {
+		return v, nil
+	}
+
+	v, err := p.accept(identifier)
+	if err != nil {
+		return nil, err
+	}
+	return v, nil
 }
 
-func (p *Parser) queryTerm() (Layout, error) {
-	return p.queryPrimary()
+func (p *Parser) authorizationIdentifier() (Layout, error) {
+	v, err := p.accept(identifier)
+	if err != nil {
+		return nil, err
+	}
+	return v, nil
 }
 
-func (p *Parser) queryPrimary() (Layout, error) {
-	if s, err := p.simpleTable(); err == nil {
-		return s, nil
+func (p *Parser) indexDefinition() (Layout, error) {
+	h := Juxtaposition{}
+
+	v, err := p.accept(keyword, "CREATE")
+	if err != nil {
+		return nil, err
+	}
+	h.Append(v)
+
+	if v, err := p.accept(keyword, "UNIQUE"); err == nil {
+		h.Append(v)
+	}
+
+	v, err = p.accept(keyword, "INDEX")
+	if err != nil {
+		return nil, err
+	}
+	h.Append(v)
+
+	if v, err := p.accept(keyword, "CONCURRENTLY"); err == nil {
+		h.Append(v)
+	}
+
+	if e, err := p.ifNotExists(); err == nil {
+		h.Append(e)
+	}
+
+	n, err := p.identifierChain()
+	if err != nil {
+		return nil, fmt.Errorf("index name: %w", err)
+	}
+	h.Append(n)
+
+	o := Juxtaposition{}
+
+	v, err = p.accept(keyword, "ON")
+	if err != nil {
+		return nil, err
+	}
+	o.Append(v)
+
+	t, err := p.tableName()
+	if err != nil {
+		return nil, fmt.Errorf("table name: %w", err)
+	}
+	o.Append(t)
+
+	u := Juxtaposition{}
+
+	if v, err := p.accept(keyword, "USING"); err == nil {
+		u.Append(v)
+
+		m, err := p.indexMethod()
+		if err != nil {
+			return nil, fmt.Errorf("index method: %w", err)
+		}
+		u.Append(m)
 	}
 
 	c := Concatenation{}
 
-	v, err := p.accept(leftParen)
+	v, err = p.accept(leftParen)
 	if err != nil {
 		return nil, err
 	}
 	c.Append(v)
 
-	b, err := p.queryExpressionBody()
+	l, err := p.sortSpecificationList()
 	if err != nil {
-		return nil, fmt.Errorf("query expression body: %w", err)
+		return nil, fmt.Errorf("sort specification list: %w", err)
 	}
-	c.Append(b)
-
-	// TODO: order by clause, result offset clause, fetch first clause
+	c.Append(l)
 
 	v, err = p.accept(rightParen)
 	if err != nil {
@@ -112,142 +232,187 @@ func (p *Parser) queryPrimary() (Layout, error) {
 	}
 	c.Append(v)
 
-	return c, nil
+	one := Stack{}
+	another := Stack{}
+
+	j := Juxtaposition{}
+	j.Append(h, o, u, c)
+	one.Append(j)
+
+	// if the header doesn't fit in a line, ON and USING go in the river.
+	another.Append(h)
+	if len(u) > 0 {
+		u.Append(c)
+		another.Append(o, u)
+	} else {
+		o.Append(c)
+		another.Append(o)
+	}
+
+	if w, err := p.whereClause(); err == nil {
+		one.Append(w)
+		another.Append(w)
+	}
+
+	one.AlignGutter()
+	another.AlignGutter()
+
+	return Choice{One: one.Strip(), Another: another}, nil
 }
 
-func (p *Parser) simpleTable() (Layout, error) {
-	return p.querySpecification()
+func (p *Parser) indexMethod() (Layout, error) {
+	if v, err := p.accept(identifier); err == nil {
+		return v, nil
+	}
+	return p.accept(keyword)
 }
 
-func (p *Parser) correspondingSpec() (Layout, error) {
+func (p *Parser) sequenceGeneratorDefinition() (Layout, error) {
 	j := Juxtaposition{}
 
-	v, err := p.accept(keyword, "CORRESPONDING")
+	v, err := p.accept(keyword, "CREATE")
 	if err != nil {
 		return nil, err
 	}
 	j.Append(v)
 
-	if v, err := p.accept(keyword, "BY"); err == nil {
+	if v, err := p.accept(keyword, "TEMPORARY", "TEMP"); err == nil {
 		j.Append(v)
+	}
 
-		c := Concatenation{}
+	v, err = p.accept(keyword, "SEQUENCE")
+	if err != nil {
+		return nil, err
+	}
+	j.Append(v)
 
-		v, err = p.accept(leftParen)
-		if err != nil {
-			return nil, err
-		}
-		c.Append(v)
+	if e, err := p.ifNotExists(); err == nil {
+		j.Append(e)
+	}
 
-		l, err := p.correspondingColumnList()
-		if err != nil {
-			return nil, err
-		}
-		c.Append(l)
+	n, err := p.identifierChain()
+	if err != nil {
+		return nil, fmt.Errorf("sequence generator name: %w", err)
+	}
+	j.Append(n)
 
-		v, err = p.accept(rightParen)
+	var os []Layout
+	for {
+		o, err := p.sequenceGeneratorOption()
 		if err != nil {
-			return nil, err
+			break
 		}
-		c.Append(v)
+		os = append(os, o)
+	}
 
-		j.Append(c)
+	if len(os) == 0 {
+		return j, nil
 	}
 
-	return j, nil
-}
+	one := Juxtaposition{}
+	one.Append(j)
+	one.Append(os...)
 
-func (p *Parser) correspondingColumnList() (Layout, error) {
-	return p.columnNameList()
+	// if the options don't fit next to the name, they go one per line under it.
+	return Choice{
+		One:     one,
+		Another: Stack{j, Indent{Stack(os)}},
+	}, nil
 }
 
-func (p *Parser) querySpecification() (Layout, error) {
+func (p *Parser) sequenceGeneratorOption() (Layout, error) {
 	j := Juxtaposition{}
-	{
-		v, err := p.accept(keyword, "SELECT")
-		if err != nil {
-			return nil, err
-		}
+
+	if v, err := p.accept(keyword, "AS"); err == nil {
 		j.Append(v)
 
-		s, err := p.selectList()
+		t, err := p.dataType()
 		if err != nil {
-			return nil, fmt.Errorf("select list: %w", err)
+			return nil, fmt.Errorf("data type: %w", err)
 		}
-		j.Append(s)
-	}
-
-	s := Stack{}
-	s.Append(j)
+		j.Append(t)
 
-	t, err := p.tableExpression()
-	if err != nil {
-		return nil, fmt.Errorf("table list: %w", err)
+		return j, nil
 	}
-	s.Append(t)
 
-	s.AlignGutter()
+	if v, err := p.accept(keyword, "START"); err == nil {
+		j.Append(v)
 
-	return s, nil
-}
+		if v, err := p.accept(keyword, "WITH"); err == nil {
+			j.Append(v)
+		}
 
-func (p *Parser) selectList() (Layout, error) {
-	if v, err := p.accept(asterisk); err == nil {
-		return v, nil
+		n, err := p.signedNumericLiteral()
+		if err != nil {
+			return nil, fmt.Errorf("sequence generator start value: %w", err)
+		}
+		j.Append(n)
+
+		return j, nil
 	}
 
-	j := Juxtaposition{}
+	if v, err := p.accept(keyword, "INCREMENT"); err == nil {
+		j.Append(v)
 
-	s, err := p.selectSublist()
-	if err != nil {
-		return nil, fmt.Errorf("select sublist: %w", err)
-	}
+		if v, err := p.accept(keyword, "BY"); err == nil {
+			j.Append(v)
+		}
 
-	for {
-		v, err := p.accept(comma)
+		n, err := p.signedNumericLiteral()
 		if err != nil {
-			break
+			return nil, fmt.Errorf("sequence generator increment: %w", err)
 		}
-		j.Append(Concatenation{s, v})
+		j.Append(n)
 
-		s, err = p.selectSublist()
+		return j, nil
+	}
+
+	if v, err := p.accept(keyword, "MAXVALUE", "MINVALUE", "CACHE"); err == nil {
+		j.Append(v)
+
+		n, err := p.signedNumericLiteral()
 		if err != nil {
-			return nil, fmt.Errorf("select sublist: %w", err)
+			return nil, fmt.Errorf("sequence generator value: %w", err)
 		}
+		j.Append(n)
+
+		return j, nil
 	}
-	j.Append(s)
 
-	return j, nil
-}
+	if v, err := p.accept(keyword, "CYCLE"); err == nil {
+		return v, nil
+	}
 
-func (p *Parser) selectSublist() (Layout, error) {
-	return p.derivedColumn()
-}
+	if v, err := p.accept(keyword, "NO"); err == nil {
+		j.Append(v)
 
-func (p *Parser) derivedColumn() (Layout, error) {
-	j := Juxtaposition{}
+		v, err := p.accept(keyword, "MAXVALUE", "MINVALUE", "CYCLE")
+		if err != nil {
+			return nil, err
+		}
+		j.Append(v)
 
-	v, err := p.valueExpression()
+		return j, nil
+	}
+
+	v, err := p.accept(keyword, "OWNED")
 	if err != nil {
-		return nil, fmt.Errorf("value expression: %w", err)
+		return nil, err
 	}
 	j.Append(v)
 
-	if a, err := p.asClause(); err == nil {
-		j.Append(a)
+	v, err = p.accept(keyword, "BY")
+	if err != nil {
+		return nil, err
 	}
+	j.Append(v)
 
-	return j.Strip(), nil
-}
-
-func (p *Parser) asClause() (Layout, error) {
-	j := Juxtaposition{}
-
-	if v, err := p.accept(keyword, "AS"); err == nil {
+	if v, err := p.accept(keyword, "NONE"); err == nil {
 		j.Append(v)
+		return j, nil
 	}
 
-	c, err := p.columnName()
+	c, err := p.identifierChain()
 	if err != nil {
 		return nil, fmt.Errorf("column name: %w", err)
 	}
@@ -256,99 +421,6662 @@ func (p *Parser) asClause() (Layout, error) {
 	return j, nil
 }
 
-func (p *Parser) valueExpression() (Layout, error) {
-	return p.commonValueExpression()
-}
+func (p *Parser) signedNumericLiteral() (Layout, error) {
+	c := Concatenation{}
 
-func (p *Parser) commonValueExpression() (Layout, error) {
-	if e, err := p.stringValueExpression(); err == nil {
+	if s, err := p.sign(); err == nil {
+		c.Append(s)
+	}
+
+	n, err := p.unsignedNumericLiteral()
+	if err != nil {
+		return nil, err
+	}
+	c.Append(n)
+
+	return c.Strip(), nil
+}
+
+func (p *Parser) ifNotExists() (Layout, error) {
+	j := Juxtaposition{}
+
+	v, err := p.accept(keyword, "IF")
+	if err != nil {
+		return nil, err
+	}
+	j.Append(v)
+
+	v, err = p.accept(keyword, "NOT")
+	if err != nil {
+		return nil, err
+	}
+	j.Append(v)
+
+	v, err = p.accept(keyword, "EXISTS")
+	if err != nil {
+		return nil, err
+	}
+	j.Append(v)
+
+	return j, nil
+}
+
+func (p *Parser) viewDefinition() (Layout, error) {
+	j := Juxtaposition{}
+
+	v, err := p.accept(keyword, "CREATE")
+	if err != nil {
+		return nil, err
+	}
+	j.Append(v)
+
+	if v, err := p.accept(keyword, "OR"); err == nil {
+		j.Append(v)
+
+		v, err := p.accept(keyword, "REPLACE")
+		if err != nil {
+			return nil, err
+		}
+		j.Append(v)
+	}
+
+	if v, err := p.accept(keyword, "RECURSIVE", "MATERIALIZED"); err == nil {
+		j.Append(v)
+	}
+
+	v, err = p.accept(keyword, "VIEW")
+	if err != nil {
+		return nil, err
+	}
+	j.Append(v)
+
+	n, err := p.tableName()
+	if err != nil {
+		return nil, fmt.Errorf("table name: %w", err)
+	}
+	j.Append(n)
+
+	if l, err := p.parenthesizedColumnNameList(); err == nil {
+		j.Append(l)
+	}
+
+	v, err = p.accept(keyword, "AS")
+	if err != nil {
+		return nil, err
+	}
+	j.Append(v)
+
+	q, err := p.cursorSpecification()
+	if err != nil {
+		return nil, fmt.Errorf("query expression: %w", err)
+	}
+
+	// the query keeps its own river, indented beneath AS.
+	s := Stack{j, Indent{q}}
+
+	if o, err := p.viewOption(); err == nil {
+		s = append(s, o)
+	}
+
+	return s, nil
+}
+
+func (p *Parser) viewOption() (Layout, error) {
+	j := Juxtaposition{}
+
+	v, err := p.accept(keyword, "WITH")
+	if err != nil {
+		return nil, err
+	}
+	j.Append(v)
+
+	if v, err := p.accept(keyword, "NO"); err == nil {
+		j.Append(v)
+
+		v, err := p.accept(keyword, "DATA")
+		if err != nil {
+			return nil, err
+		}
+		j.Append(v)
+
+		return j, nil
+	}
+
+	if v, err := p.accept(keyword, "DATA"); err == nil {
+		j.Append(v)
+		return j, nil
+	}
+
+	if v, err := p.accept(keyword, "CASCADED", "LOCAL"); err == nil {
+		j.Append(v)
+	}
+
+	v, err = p.accept(keyword, "CHECK")
+	if err != nil {
+		return nil, err
+	}
+	j.Append(v)
+
+	v, err = p.accept(keyword, "OPTION")
+	if err != nil {
+		return nil, err
+	}
+	j.Append(v)
+
+	return j, nil
+}
+
+func (p *Parser) tableDefinition() (Layout, error) {
+	j := Juxtaposition{}
+
+	v, err := p.accept(keyword, "CREATE")
+	if err != nil {
+		return nil, err
+	}
+	j.Append(v)
+
+	if s, err := p.tableScope(); err == nil {
+		j.Append(s)
+	}
+
+	v, err = p.accept(keyword, "TABLE")
+	if err != nil {
+		return nil, err
+	}
+	j.Append(v)
+
+	n, err := p.tableName()
+	if err != nil {
+		return nil, fmt.Errorf("table name: %w", err)
+	}
+	j.Append(n)
+
+	l, err := p.tableElementList()
+	if err != nil {
+		return nil, fmt.Errorf("table element list: %w", err)
+	}
+
+	one := Juxtaposition{}
+	one.Append(j, Concatenation{Atom("("), l.Strip(), Atom(")")})
+
+	h := Juxtaposition{}
+	h.Append(j, Atom("("))
+
+	another := Stack{h, Indent{l.Strip()}, Atom(")")}
+
+	if a, err := p.onCommitAction(); err == nil {
+		one.Append(a)
+		another[len(another)-1] = Juxtaposition{Atom(")"), a}
+	}
+
+	// more than one element are always laid out in a block under the table name.
+	if len(l) > 1 {
+		return another, nil
+	}
+
+	// a single element hangs under the table name only if it doesn't fit next to it.
+	return Choice{One: one, Another: another}, nil
+}
+
+func (p *Parser) tableScope() (Layout, error) {
+	j := Juxtaposition{}
+
+	if v, err := p.accept(keyword, "GLOBAL", "LOCAL"); err == nil {
+		j.Append(v)
+	}
+
+	v, err := p.accept(keyword, "TEMPORARY", "TEMP")
+	if err != nil {
+		return nil, err
+	}
+	j.Append(v)
+
+	return j, nil
+}
+
+func (p *Parser) onCommitAction() (Layout, error) {
+	j := Juxtaposition{}
+
+	v, err := p.accept(keyword, "ON")
+	if err != nil {
+		return nil, err
+	}
+	j.Append(v)
+
+	v, err = p.accept(keyword, "COMMIT")
+	if err != nil {
+		return nil, err
+	}
+	j.Append(v)
+
+	v, err = p.accept(keyword, "PRESERVE", "DELETE")
+	if err != nil {
+		return nil, err
+	}
+	j.Append(v)
+
+	v, err = p.accept(keyword, "ROWS")
+	if err != nil {
+		return nil, err
+	}
+	j.Append(v)
+
+	return j, nil
+}
+
+func (p *Parser) tableElementList() (Stack, error) {
+	if _, err := p.accept(leftParen); err != nil {
+		return nil, err
+	}
+
+	var (
+		es []Layout
+		ds []Juxtaposition
+		is []int
+	)
+
+	for {
+		if c, err := p.try(p.tableConstraintDefinition); err == nil {
+			es = append(es, c)
+		} else {
+			d, err := p.columnDefinition()
+			if err != nil {
+				return nil, fmt.Errorf("column definition: %w", err)
+			}
+			is = append(is, len(es))
+			ds = append(ds, d.(Juxtaposition))
+			es = append(es, d)
+		}
+
+		if _, err := p.accept(comma); err != nil {
+			break
+		}
+	}
+
+	if _, err := p.accept(rightParen); err != nil {
+		return nil, err
+	}
+
+	// names, data types and constraints of columns line up in columns.
+	if as, ok := alignColumns(ds); ok {
+		for k, i := range is {
+			es[i] = as[k]
+		}
+	}
+
+	s := Stack{}
+	for i, e := range es {
+		if i < len(es)-1 {
+			e = Concatenation{e, Atom(",")}
+		}
+		s = append(s, e)
+	}
+
+	return s, nil
+}
+
+// columnDefinition returns a Juxtaposition of the column name, the data type and the rest
+// so that tableElementList can line them up.
+func (p *Parser) columnDefinition() (Layout, error) {
+	n, err := p.columnName()
+	if err != nil {
+		return nil, fmt.Errorf("column name: %w", err)
+	}
+	j := Juxtaposition{n}
+
+	if t, err := p.dataType(); err == nil {
+		j = append(j, t)
+	}
+
+	r := Juxtaposition{}
+
+	// the default clause is accepted among the constraints as it often comes after NOT NULL.
+	for {
+		if d, err := p.defaultClause(); err == nil {
+			r.Append(d)
+			continue
+		}
+
+		c, err := p.columnConstraintDefinition()
+		if err != nil {
+			break
+		}
+		r.Append(c)
+	}
+
+	if c, err := p.collateClause(); err == nil {
+		r.Append(c)
+	}
+
+	if len(r) > 0 {
+		j = append(j, r.Strip())
+	}
+
+	return j, nil
+}
+
+func (p *Parser) defaultClause() (Layout, error) {
+	j := Juxtaposition{}
+
+	v, err := p.accept(keyword, "DEFAULT")
+	if err != nil {
+		return nil, err
+	}
+	j.Append(v)
+
+	o, err := p.defaultOption()
+	if err != nil {
+		return nil, fmt.Errorf("default option: %w", err)
+	}
+	j.Append(o)
+
+	return j, nil
+}
+
+func (p *Parser) defaultOption() (Layout, error) {
+	if v, err := p.accept(keyword, "CURRENT_DATE", "CURRENT_TIME", "CURRENT_TIMESTAMP", "LOCALTIME", "LOCALTIMESTAMP", "USER", "CURRENT_USER", "CURRENT_ROLE", "SESSION_USER", "SYSTEM_USER", "CURRENT_PATH"); err == nil {
+		if l, err := p.parenthesizedPrecision(); err == nil {
+			return Concatenation{v, l}, nil
+		}
+		return v, nil
+	}
+	return p.valueExpression()
+}
+
+func (p *Parser) collateClause() (Layout, error) {
+	j := Juxtaposition{}
+
+	v, err := p.accept(keyword, "COLLATE")
+	if err != nil {
+		return nil, err
+	}
+	j.Append(v)
+
+	n, err := p.identifierChain()
+	if err != nil {
+		return nil, fmt.Errorf("collation name: %w", err)
+	}
+	j.Append(n)
+
+	return j, nil
+}
+
+func (p *Parser) columnConstraintDefinition() (Layout, error) {
+	j := Juxtaposition{}
+
+	if n, err := p.constraintNameDefinition(); err == nil {
+		j.Append(n)
+	}
+
+	c, err := p.columnConstraint()
+	if err != nil {
+		return nil, fmt.Errorf("column constraint: %w", err)
+	}
+	j.Append(c)
+
+	if c, err := p.try(p.constraintCharacteristics); err == nil {
+		j.Append(c)
+	}
+
+	return j, nil
+}
+
+func (p *Parser) constraintNameDefinition() (Layout, error) {
+	j := Juxtaposition{}
+
+	v, err := p.accept(keyword, "CONSTRAINT")
+	if err != nil {
+		return nil, err
+	}
+	j.Append(v)
+
+	n, err := p.identifierChain()
+	if err != nil {
+		return nil, fmt.Errorf("constraint name: %w", err)
+	}
+	j.Append(n)
+
+	return j, nil
+}
+
+func (p *Parser) columnConstraint() (Layout, error) {
+	if v, err := p.accept(keyword, "NOT"); err == nil {
+		n, err := p.accept(keyword, "NULL")
+		if err != nil {
+			return nil, err
+		}
+		return Juxtaposition{v, n}, nil
+	}
+
+	if v, err := p.accept(keyword, "NULL"); err == nil {
+		return v, nil
+	}
+
+	if u, err := p.uniqueSpecification(); err == nil {
+		return u, nil
+	}
+
+	if r, err := p.referencesSpecification(); err == nil {
+		return r, nil
+	}
+
+	return p.checkConstraintDefinition()
+}
+
+func (p *Parser) uniqueSpecification() (Layout, error) {
+	if v, err := p.accept(keyword, "UNIQUE"); err == nil {
+		return v, nil
+	}
+
+	j := Juxtaposition{}
+
+	v, err := p.accept(keyword, "PRIMARY")
+	if err != nil {
+		return nil, err
+	}
+	j.Append(v)
+
+	v, err = p.accept(keyword, "KEY")
+	if err != nil {
+		return nil, err
+	}
+	j.Append(v)
+
+	return j, nil
+}
+
+func (p *Parser) referencesSpecification() (Layout, error) {
+	j := Juxtaposition{}
+
+	v, err := p.accept(keyword, "REFERENCES")
+	if err != nil {
+		return nil, err
+	}
+	j.Append(v)
+
+	t, err := p.tableName()
+	if err != nil {
+		return nil, fmt.Errorf("table name: %w", err)
+	}
+	j.Append(t)
+
+	if l, err := p.parenthesizedColumnNameList(); err == nil {
+		j.Append(l)
+	}
+
+	if v, err := p.accept(keyword, "MATCH"); err == nil {
+		j.Append(v)
+
+		v, err := p.accept(keyword, "FULL", "PARTIAL", "SIMPLE")
+		if err != nil {
+			return nil, err
+		}
+		j.Append(v)
+	}
+
+	for {
+		a, err := p.try(p.referentialAction)
+		if err != nil {
+			break
+		}
+		j.Append(a)
+	}
+
+	return j, nil
+}
+
+func (p *Parser) referentialAction() (Layout, error) {
+	j := Juxtaposition{}
+
+	v, err := p.accept(keyword, "ON")
+	if err != nil {
+		return nil, err
+	}
+	j.Append(v)
+
+	v, err = p.accept(keyword, "UPDATE", "DELETE")
+	if err != nil {
+		return nil, err
+	}
+	j.Append(v)
+
+	if v, err := p.accept(keyword, "CASCADE", "RESTRICT"); err == nil {
+		j.Append(v)
+		return j, nil
+	}
+
+	if v, err := p.accept(keyword, "SET"); err == nil {
+		j.Append(v)
+
+		v, err := p.accept(keyword, "NULL", "DEFAULT")
+		if err != nil {
+			return nil, err
+		}
+		j.Append(v)
+
+		return j, nil
+	}
+
+	v, err = p.accept(keyword, "NO")
+	if err != nil {
+		return nil, err
+	}
+	j.Append(v)
+
+	v, err = p.accept(keyword, "ACTION")
+	if err != nil {
+		return nil, err
+	}
+	j.Append(v)
+
+	return j, nil
+}
+
+func (p *Parser) checkConstraintDefinition() (Layout, error) {
+	j := Juxtaposition{}
+
+	v, err := p.accept(keyword, "CHECK")
+	if err != nil {
+		return nil, err
+	}
+	j.Append(v)
+
+	c := Concatenation{}
+
+	v, err = p.accept(leftParen)
+	if err != nil {
+		return nil, err
+	}
+	c.Append(v)
+
+	s, err := p.searchCondition()
+	if err != nil {
+		return nil, fmt.Errorf("search condition: %w", err)
+	}
+	c.Append(s)
+
+	v, err = p.accept(rightParen)
+	if err != nil {
+		return nil, err
+	}
+	c.Append(v)
+
+	j.Append(c)
+
+	return j, nil
+}
+
+func (p *Parser) constraintCharacteristics() (Layout, error) {
+	j := Juxtaposition{}
+
+	if v, err := p.accept(keyword, "NOT"); err == nil {
+		j.Append(v)
+
+		v, err := p.accept(keyword, "DEFERRABLE")
+		if err != nil {
+			return nil, err
+		}
+		j.Append(v)
+	} else if v, err := p.accept(keyword, "DEFERRABLE"); err == nil {
+		j.Append(v)
+	}
+
+	if v, err := p.accept(keyword, "INITIALLY"); err == nil {
+		j.Append(v)
+
+		v, err := p.accept(keyword, "DEFERRED", "IMMEDIATE")
+		if err != nil {
+			return nil, err
+		}
+		j.Append(v)
+	}
+
+	if len(j) == 0 {
+		return nil, &ErrUnexpected{
+			ExpectedType:   keyword,
+			ExpectedValues: []string{"NOT", "DEFERRABLE", "INITIALLY"},
+			Actual:         p.current,
+		}
+	}
+
+	return j, nil
+}
+
+func (p *Parser) tableConstraintDefinition() (Layout, error) {
+	j := Juxtaposition{}
+
+	if n, err := p.constraintNameDefinition(); err == nil {
+		j.Append(n)
+	}
+
+	c, err := p.tableConstraint()
+	if err != nil {
+		return nil, fmt.Errorf("table constraint: %w", err)
+	}
+	j.Append(c)
+
+	if c, err := p.try(p.constraintCharacteristics); err == nil {
+		j.Append(c)
+	}
+
+	return j, nil
+}
+
+func (p *Parser) tableConstraint() (Layout, error) {
+	if u, err := p.uniqueConstraintDefinition(); err == nil {
+		return u, nil
+	}
+
+	if r, err := p.referentialConstraintDefinition(); err == nil {
+		return r, nil
+	}
+
+	return p.checkConstraintDefinition()
+}
+
+func (p *Parser) uniqueConstraintDefinition() (Layout, error) {
+	j := Juxtaposition{}
+
+	u, err := p.uniqueSpecification()
+	if err != nil {
+		return nil, err
+	}
+	j.Append(u)
+
+	l, err := p.parenthesizedColumnNameList()
+	if err != nil {
+		return nil, fmt.Errorf("unique column list: %w", err)
+	}
+	j.Append(l)
+
+	return j, nil
+}
+
+func (p *Parser) referentialConstraintDefinition() (Layout, error) {
+	j := Juxtaposition{}
+
+	v, err := p.accept(keyword, "FOREIGN")
+	if err != nil {
+		return nil, err
+	}
+	j.Append(v)
+
+	v, err = p.accept(keyword, "KEY")
+	if err != nil {
+		return nil, err
+	}
+	j.Append(v)
+
+	l, err := p.parenthesizedColumnNameList()
+	if err != nil {
+		return nil, fmt.Errorf("referencing columns: %w", err)
+	}
+	j.Append(l)
+
+	r, err := p.referencesSpecification()
+	if err != nil {
+		return nil, fmt.Errorf("references specification: %w", err)
+	}
+	j.Append(r)
+
+	return j, nil
+}
+
+func (p *Parser) parenthesizedColumnNameList() (Layout, error) {
+	c := Concatenation{}
+
+	v, err := p.accept(leftParen)
+	if err != nil {
+		return nil, err
+	}
+	c.Append(v)
+
+	l, err := p.columnNameList()
+	if err != nil {
+		return nil, fmt.Errorf("column name list: %w", err)
+	}
+	c.Append(l)
+
+	v, err = p.accept(rightParen)
+	if err != nil {
+		return nil, err
+	}
+	c.Append(v)
+
+	return c, nil
+}
+
+func (p *Parser) sqlSchemaManipulationStatement() (Layout, error) {
+	if _, err := p.expect(keyword, "REVOKE"); err == nil {
+		return p.revokeStatement()
+	}
+	if _, err := p.expect(keyword, "ALTER"); err == nil {
+		if s, err := p.try(p.alterDefaultPrivilegesStatement); err == nil {
+			return s, nil
+		}
+		return p.alterTableStatement()
+	}
+	return p.dropTableStatement()
+}
+
+func (p *Parser) grantStatement() (Layout, error) {
+	s, err := p.try(p.grantPrivilegeStatement)
+	if err == nil {
+		return s, nil
+	}
+
+	// the error of the privilege grant tells more as it's by far the more common form.
+	if s, err := p.try(p.grantRoleStatement); err == nil {
+		return s, nil
+	}
+	return nil, fmt.Errorf("grant privilege statement: %w", err)
+}
+
+func (p *Parser) grantPrivilegeStatement() (Layout, error) {
+	one := Juxtaposition{}
+	another := Stack{}
+
+	v, err := p.accept(keyword, "GRANT")
+	if err != nil {
+		return nil, err
+	}
+
+	a, err := p.objectPrivileges()
+	if err != nil {
+		return nil, fmt.Errorf("object privileges: %w", err)
+	}
+	one.Append(v, a.One)
+	another.Append(Juxtaposition{v, a})
+
+	o, err := p.onObjectName()
+	if err != nil {
+		return nil, fmt.Errorf("object name: %w", err)
+	}
+	one.Append(o)
+	another.Append(o)
+
+	v, err = p.accept(keyword, "TO")
+	if err != nil {
+		return nil, err
+	}
+
+	g, err := p.granteeList()
+	if err != nil {
+		return nil, fmt.Errorf("grantee list: %w", err)
+	}
+	one.Append(v, g.One)
+	another.Append(Juxtaposition{v, g})
+
+	for {
+		w, err := p.try(p.withGrantOption)
+		if err != nil {
+			break
+		}
+		one.Append(w)
+		another.Append(w)
+	}
+
+	if b, err := p.grantedBy(); err == nil {
+		one.Append(b)
+		another.Append(b)
+	}
+
+	another.AlignGutter()
+
+	// if it doesn't fit in a line, each clause goes in the river.
+	return Choice{One: one, Another: another}, nil
+}
+
+func (p *Parser) objectPrivileges() (Choice, error) {
+	if v, err := p.accept(keyword, "ALL"); err == nil {
+		j := Juxtaposition{v}
+
+		if v, err := p.accept(keyword, "PRIVILEGES"); err == nil {
+			j.Append(v)
+		}
+
+		return Choice{One: j, Another: j}, nil
+	}
+
+	var as []Layout
+
+	a, err := p.action()
+	if err != nil {
+		return Choice{}, fmt.Errorf("action: %w", err)
+	}
+	as = append(as, a)
+
+	for {
+		if _, err := p.accept(comma); err != nil {
+			break
+		}
+
+		a, err := p.action()
+		if err != nil {
+			return Choice{}, fmt.Errorf("action: %w", err)
+		}
+		as = append(as, a)
+	}
+
+	return wrappedList(as), nil
+}
+
+func (p *Parser) action() (Layout, error) {
+	if v, err := p.accept(keyword, "SELECT", "INSERT", "UPDATE", "REFERENCES"); err == nil {
+		if l, err := p.parenthesizedColumnNameList(); err == nil {
+			return Juxtaposition{v, l}, nil
+		}
+		return v, nil
+	}
+
+	v, err := p.accept(keyword, "DELETE", "USAGE", "TRIGGER", "UNDER", "EXECUTE", "TRUNCATE", "CONNECT", "CREATE", "TEMPORARY", "TEMP")
+	if err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+func (p *Parser) onObjectName() (Layout, error) {
+	j := Juxtaposition{}
+
+	v, err := p.accept(keyword, "ON")
+	if err != nil {
+		return nil, err
+	}
+	j.Append(v)
+
+	o, err := p.objectName()
+	if err != nil {
+		return nil, err
+	}
+	j.Append(o)
+
+	return j, nil
+}
+
+func (p *Parser) objectName() (Layout, error) {
+	j := Juxtaposition{}
+
+	if v, err := p.accept(keyword, "ALL"); err == nil {
+		j.Append(v)
+
+		v, err := p.accept(keyword, "TABLES", "SEQUENCES", "FUNCTIONS", "ROUTINES")
+		if err != nil {
+			return nil, err
+		}
+		j.Append(v)
+
+		v, err = p.accept(keyword, "IN")
+		if err != nil {
+			return nil, err
+		}
+		j.Append(v)
+
+		v, err = p.accept(keyword, "SCHEMA")
+		if err != nil {
+			return nil, err
+		}
+		j.Append(v)
+
+		l, err := p.schemaNameList()
+		if err != nil {
+			return nil, fmt.Errorf("schema name list: %w", err)
+		}
+		j.Append(l)
+
+		return j, nil
+	}
+
+	if v, err := p.accept(keyword, "TABLES", "SEQUENCES", "FUNCTIONS", "ROUTINES", "SCHEMAS"); err == nil {
+		return v, nil
+	}
+
+	if v, err := p.accept(keyword, "SCHEMA"); err == nil {
+		j.Append(v)
+
+		n, err := p.schemaName()
+		if err != nil {
+			return nil, fmt.Errorf("schema name: %w", err)
+		}
+		j.Append(n)
+
+		return j, nil
+	}
+
+	if v, err := p.accept(keyword, "TABLE", "DOMAIN", "SEQUENCE", "DATABASE", "FUNCTION", "PROCEDURE", "ROUTINE", "TYPE"); err == nil {
+		j.Append(v)
+	}
+
+	n, err := p.identifierChain()
+	if err != nil {
+		return nil, fmt.Errorf("object name: %w", err)
+	}
+	j.Append(n)
+
+	return j, nil
+}
+
+func (p *Parser) schemaNameList() (Layout, error) {
+	j := Juxtaposition{}
+
+	n, err := p.schemaName()
+	if err != nil {
+		return nil, fmt.Errorf("schema name: %w", err)
+	}
+
+	for {
+		v, err := p.accept(comma)
+		if err != nil {
+			break
+		}
+		j.Append(Concatenation{n, v})
+
+		n, err = p.schemaName()
+		if err != nil {
+			return nil, fmt.Errorf("schema name: %w", err)
+		}
+	}
+	j.Append(n)
+
+	return j, nil
+}
+
+func (p *Parser) granteeList() (Choice, error) {
+	var gs []Layout
+
+	g, err := p.grantee()
+	if err != nil {
+		return Choice{}, fmt.Errorf("grantee: %w", err)
+	}
+	gs = append(gs, g)
+
+	for {
+		if _, err := p.accept(comma); err != nil {
+			break
+		}
+
+		g, err := p.grantee()
+		if err != nil {
+			return Choice{}, fmt.Errorf("grantee: %w", err)
+		}
+		gs = append(gs, g)
+	}
+
+	return wrappedList(gs), nil
+}
+
+func (p *Parser) grantee() (Layout, error) {
+	if v, err := p.accept(keyword, "PUBLIC", "CURRENT_USER", "SESSION_USER"); err == nil {
+		return v, nil
+	}
+	return p.authorizationIdentifier()
+}
+
+func (p *Parser) withGrantOption() (Layout, error) {
+	j := Juxtaposition{}
+
+	v, err := p.accept(keyword, "WITH")
+	if err != nil {
+		return nil, err
+	}
+	j.Append(v)
+
+	v, err = p.accept(keyword, "GRANT", "HIERARCHY", "ADMIN")
+	if err != nil {
+		return nil, err
+	}
+	j.Append(v)
+
+	v, err = p.accept(keyword, "OPTION")
+	if err != nil {
+		return nil, err
+	}
+	j.Append(v)
+
+	return j, nil
+}
+
+func (p *Parser) grantedBy() (Layout, error) {
+	j := Juxtaposition{}
+
+	v, err := p.accept(keyword, "GRANTED")
+	if err != nil {
+		return nil, err
+	}
+	j.Append(v)
+
+	v, err = p.accept(keyword, "BY")
+	if err != nil {
+		return nil, err
+	}
+	j.Append(v)
+
+	g, err := p.grantee()
+	if err != nil {
+		return nil, fmt.Errorf("grantor: %w", err)
+	}
+	j.Append(g)
+
+	return j, nil
+}
+
+func (p *Parser) grantRoleStatement() (Layout, error) {
+	one := Juxtaposition{}
+	another := Stack{}
+
+	v, err := p.accept(keyword, "GRANT")
+	if err != nil {
+		return nil, err
+	}
+
+	r, err := p.roleNameList()
+	if err != nil {
+		return nil, fmt.Errorf("role granted: %w", err)
+	}
+	one.Append(v, r.One)
+	another.Append(Juxtaposition{v, r})
+
+	v, err = p.accept(keyword, "TO")
+	if err != nil {
+		return nil, err
+	}
+
+	g, err := p.granteeList()
+	if err != nil {
+		return nil, fmt.Errorf("grantee list: %w", err)
+	}
+	one.Append(v, g.One)
+	another.Append(Juxtaposition{v, g})
+
+	if w, err := p.withGrantOption(); err == nil {
+		one.Append(w)
+		another.Append(w)
+	}
+
+	if b, err := p.grantedBy(); err == nil {
+		one.Append(b)
+		another.Append(b)
+	}
+
+	another.AlignGutter()
+
+	return Choice{One: one, Another: another}, nil
+}
+
+func (p *Parser) roleNameList() (Choice, error) {
+	var rs []Layout
+
+	r, err := p.roleName()
+	if err != nil {
+		return Choice{}, fmt.Errorf("role name: %w", err)
+	}
+	rs = append(rs, r)
+
+	for {
+		if _, err := p.accept(comma); err != nil {
+			break
+		}
+
+		r, err := p.roleName()
+		if err != nil {
+			return Choice{}, fmt.Errorf("role name: %w", err)
+		}
+		rs = append(rs, r)
+	}
+
+	return wrappedList(rs), nil
+}
+
+func (p *Parser) roleName() (Layout, error) {
+	v, err := p.accept(identifier)
+	if err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+func (p *Parser) revokeStatement() (Layout, error) {
+	one := Juxtaposition{}
+	another := Stack{}
+
+	j := Juxtaposition{}
+
+	v, err := p.accept(keyword, "REVOKE")
+	if err != nil {
+		return nil, err
+	}
+	j.Append(v)
+
+	if o, err := p.revokeOptionExtension(); err == nil {
+		j.Append(o)
+	}
+
+	if a, err := p.try(p.revokedPrivileges); err == nil {
+		j.Append(a)
+		another.Append(j)
+
+		o, err := p.onObjectName()
+		if err != nil {
+			return nil, fmt.Errorf("object name: %w", err)
+		}
+		j.Append(o)
+		another.Append(o)
+	} else {
+		r, err := p.roleNameList()
+		if err != nil {
+			return nil, fmt.Errorf("role revoked: %w", err)
+		}
+		j.Append(r)
+		another.Append(j)
+	}
+	one.Append(j)
+
+	f := Juxtaposition{}
+
+	v, err = p.accept(keyword, "FROM")
+	if err != nil {
+		return nil, err
+	}
+	f.Append(v)
+
+	g, err := p.granteeList()
+	if err != nil {
+		return nil, fmt.Errorf("grantee list: %w", err)
+	}
+	one.Append(v, g.One)
+	f.Append(g)
+
+	if b, err := p.grantedBy(); err == nil {
+		one.Append(b)
+		f.Append(b)
+	}
+
+	if b, err := p.dropBehavior(); err == nil {
+		one.Append(b)
+		f.Append(b)
+	}
+
+	another.Append(f)
+	another.AlignGutter()
+
+	return Choice{One: one, Another: another}, nil
+}
+
+func (p *Parser) revokeOptionExtension() (Layout, error) {
+	j := Juxtaposition{}
+
+	v, err := p.accept(keyword, "GRANT", "HIERARCHY", "ADMIN")
+	if err != nil {
+		return nil, err
+	}
+	j.Append(v)
+
+	v, err = p.accept(keyword, "OPTION")
+	if err != nil {
+		return nil, err
+	}
+	j.Append(v)
+
+	v, err = p.accept(keyword, "FOR")
+	if err != nil {
+		return nil, err
+	}
+	j.Append(v)
+
+	return j, nil
+}
+
+// revokedPrivileges parses object privileges followed by ON so that they're told from revoked roles.
+func (p *Parser) revokedPrivileges() (Layout, error) {
+	a, err := p.objectPrivileges()
+	if err != nil {
+		return nil, err
+	}
+	if _, err := p.expect(keyword, "ON"); err != nil {
+		return nil, err
+	}
+	return a, nil
+}
+
+func (p *Parser) roleDefinition() (Layout, error) {
+	j := Juxtaposition{}
+
+	v, err := p.accept(keyword, "CREATE")
+	if err != nil {
+		return nil, err
+	}
+	j.Append(v)
+
+	v, err = p.accept(keyword, "ROLE", "USER")
+	if err != nil {
+		return nil, err
+	}
+	j.Append(v)
+
+	n, err := p.roleName()
+	if err != nil {
+		return nil, fmt.Errorf("role name: %w", err)
+	}
+	j.Append(n)
+
+	if v, err := p.accept(keyword, "WITH"); err == nil {
+		j.Append(v)
+	}
+
+	var os []Layout
+	for {
+		o, err := p.roleOption()
+		if err != nil {
+			break
+		}
+		os = append(os, o)
+	}
+
+	if len(os) == 0 {
+		return j, nil
+	}
+
+	one := Juxtaposition{}
+	one.Append(j)
+	one.Append(os...)
+
+	return Choice{
+		One:     one,
+		Another: Stack{j, Indent{Stack(os)}},
+	}, nil
+}
+
+// roleOption parses an option of CREATE ROLE such as LOGIN, PASSWORD 'secret' or IN ROLE admins.
+func (p *Parser) roleOption() (Layout, error) {
+	j := Juxtaposition{}
+
+	if v, err := p.accept(keyword, "IN"); err == nil {
+		j.Append(v)
+
+		v, err := p.accept(keyword, "ROLE", "GROUP")
+		if err != nil {
+			return nil, err
+		}
+		j.Append(v)
+
+		r, err := p.roleNameList()
+		if err != nil {
+			return nil, fmt.Errorf("role name list: %w", err)
+		}
+		j.Append(r.One)
+
+		return j, nil
+	}
+
+	if v, err := p.accept(keyword, "ROLE", "ADMIN", "USER"); err == nil {
+		j.Append(v)
+
+		r, err := p.roleNameList()
+		if err != nil {
+			return nil, fmt.Errorf("role name list: %w", err)
+		}
+		j.Append(r.One)
+
+		return j, nil
+	}
+
+	if v, err := p.accept(keyword, "CONNECTION"); err == nil {
+		j.Append(v)
+
+		v, err := p.accept(keyword, "LIMIT")
+		if err != nil {
+			return nil, err
+		}
+		j.Append(v)
+
+		n, err := p.signedNumericLiteral()
+		if err != nil {
+			return nil, fmt.Errorf("connection limit: %w", err)
+		}
+		j.Append(n)
+
+		return j, nil
+	}
+
+	if v, err := p.accept(keyword, "VALID"); err == nil {
+		j.Append(v)
+
+		v, err := p.accept(keyword, "UNTIL")
+		if err != nil {
+			return nil, err
+		}
+		j.Append(v)
+
+		s, err := p.accept(characterString)
+		if err != nil {
+			return nil, err
+		}
+		j.Append(s)
+
+		return j, nil
+	}
+
+	if v, err := p.accept(keyword, "ENCRYPTED"); err == nil {
+		j.Append(v)
+	}
+
+	if v, err := p.accept(keyword, "PASSWORD"); err == nil {
+		j.Append(v)
+
+		if v, err := p.accept(keyword, "NULL"); err == nil {
+			j.Append(v)
+			return j, nil
+		}
+
+		s, err := p.accept(characterString)
+		if err != nil {
+			return nil, err
+		}
+		j.Append(s)
+
+		return j, nil
+	}
+
+	if len(j) > 0 {
+		return nil, &ErrUnexpected{
+			ExpectedType:   keyword,
+			ExpectedValues: []string{"PASSWORD"},
+			Actual:         p.current,
+		}
+	}
+
+	v, err := p.accept(keyword,
+		"SUPERUSER", "NOSUPERUSER",
+		"CREATEDB", "NOCREATEDB",
+		"CREATEROLE", "NOCREATEROLE",
+		"INHERIT", "NOINHERIT",
+		"LOGIN", "NOLOGIN",
+		"REPLICATION", "NOREPLICATION",
+		"BYPASSRLS", "NOBYPASSRLS",
+	)
+	if err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+func (p *Parser) alterDefaultPrivilegesStatement() (Layout, error) {
+	j := Juxtaposition{}
+
+	v, err := p.accept(keyword, "ALTER")
+	if err != nil {
+		return nil, err
+	}
+	j.Append(v)
+
+	v, err = p.accept(keyword, "DEFAULT")
+	if err != nil {
+		return nil, err
+	}
+	j.Append(v)
+
+	v, err = p.accept(keyword, "PRIVILEGES")
+	if err != nil {
+		return nil, err
+	}
+	j.Append(v)
+
+	if v, err := p.accept(keyword, "FOR"); err == nil {
+		j.Append(v)
+
+		v, err := p.accept(keyword, "ROLE", "USER")
+		if err != nil {
+			return nil, err
+		}
+		j.Append(v)
+
+		r, err := p.roleNameList()
+		if err != nil {
+			return nil, fmt.Errorf("role name list: %w", err)
+		}
+		j.Append(r.One)
+	}
+
+	if v, err := p.accept(keyword, "IN"); err == nil {
+		j.Append(v)
+
+		v, err := p.accept(keyword, "SCHEMA")
+		if err != nil {
+			return nil, err
+		}
+		j.Append(v)
+
+		l, err := p.schemaNameList()
+		if err != nil {
+			return nil, fmt.Errorf("schema name list: %w", err)
+		}
+		j.Append(l)
+	}
+
+	var a Layout
+	if _, err = p.expect(keyword, "GRANT"); err == nil {
+		a, err = p.grantPrivilegeStatement()
+	} else {
+		a, err = p.revokeStatement()
+	}
+	if err != nil {
+		return nil, fmt.Errorf("abbreviated grant or revoke: %w", err)
+	}
+
+	// the abbreviated GRANT or REVOKE is indented beneath the header.
+	return Stack{j, Indent{a}}, nil
+}
+
+func (p *Parser) alterTableStatement() (Layout, error) {
+	j := Juxtaposition{}
+
+	v, err := p.accept(keyword, "ALTER")
+	if err != nil {
+		return nil, err
+	}
+	j.Append(v)
+
+	v, err = p.accept(keyword, "TABLE")
+	if err != nil {
+		return nil, err
+	}
+	j.Append(v)
+
+	if e, err := p.ifExists(); err == nil {
+		j.Append(e)
+	}
+
+	n, err := p.tableName()
+	if err != nil {
+		return nil, fmt.Errorf("table name: %w", err)
+	}
+	j.Append(n)
+
+	var as []Layout
+
+	a, err := p.alterTableAction()
+	if err != nil {
+		return nil, fmt.Errorf("alter table action: %w", err)
+	}
+	as = append(as, a)
+
+	for {
+		if _, err := p.accept(comma); err != nil {
+			break
+		}
+
+		a, err := p.alterTableAction()
+		if err != nil {
+			return nil, fmt.Errorf("alter table action: %w", err)
+		}
+		as = append(as, a)
+	}
+
+	if len(as) == 1 {
+		j.Append(a)
+		return j, nil
+	}
+
+	// several actions go one per line under the table name.
+	l := Stack{}
+	for i, a := range as {
+		if i < len(as)-1 {
+			a = Concatenation{a, Atom(",")}
+		}
+		l = append(l, a)
+	}
+
+	return Stack{j, Indent{l}}, nil
+}
+
+func (p *Parser) alterTableAction() (Layout, error) {
+	if a, err := p.try(p.addTableConstraintDefinition); err == nil {
+		return a, nil
+	}
+
+	if a, err := p.addColumnDefinition(); err == nil {
+		return a, nil
+	}
+
+	if a, err := p.alterColumnDefinition(); err == nil {
+		return a, nil
+	}
+
+	if a, err := p.try(p.dropTableConstraintDefinition); err == nil {
+		return a, nil
+	}
+
+	if a, err := p.dropColumnDefinition(); err == nil {
+		return a, nil
+	}
+
+	return p.renameDefinition()
+}
+
+func (p *Parser) addColumnDefinition() (Layout, error) {
+	j := Juxtaposition{}
+
+	v, err := p.accept(keyword, "ADD")
+	if err != nil {
+		return nil, err
+	}
+	j.Append(v)
+
+	if v, err := p.accept(keyword, "COLUMN"); err == nil {
+		j.Append(v)
+	}
+
+	if e, err := p.ifNotExists(); err == nil {
+		j.Append(e)
+	}
+
+	d, err := p.columnDefinition()
+	if err != nil {
+		return nil, fmt.Errorf("column definition: %w", err)
+	}
+	j.Append(d)
+
+	return j, nil
+}
+
+func (p *Parser) alterColumnDefinition() (Layout, error) {
+	j := Juxtaposition{}
+
+	v, err := p.accept(keyword, "ALTER")
+	if err != nil {
+		return nil, err
+	}
+	j.Append(v)
+
+	if v, err := p.accept(keyword, "COLUMN"); err == nil {
+		j.Append(v)
+	}
+
+	n, err := p.columnName()
+	if err != nil {
+		return nil, fmt.Errorf("column name: %w", err)
+	}
+	j.Append(n)
+
+	a, err := p.alterColumnAction()
+	if err != nil {
+		return nil, fmt.Errorf("alter column action: %w", err)
+	}
+	j.Append(a)
+
+	return j, nil
+}
+
+func (p *Parser) alterColumnAction() (Layout, error) {
+	j := Juxtaposition{}
+
+	if v, err := p.accept(keyword, "SET", "DROP"); err == nil {
+		j.Append(v)
+
+		if v, err := p.accept(keyword, "NOT"); err == nil {
+			j.Append(v)
+
+			v, err := p.accept(keyword, "NULL")
+			if err != nil {
+				return nil, err
+			}
+			j.Append(v)
+
+			return j, nil
+		}
+
+		if d, err := p.try(p.defaultClause); err == nil {
+			j.Append(d)
+			return j, nil
+		}
+
+		if v, err := p.accept(keyword, "DEFAULT"); err == nil {
+			j.Append(v)
+			return j, nil
+		}
+
+		v, err := p.accept(keyword, "DATA")
+		if err != nil {
+			return nil, err
+		}
+		j.Append(v)
+	}
+
+	v, err := p.accept(keyword, "TYPE")
+	if err != nil {
+		return nil, err
+	}
+	j.Append(v)
+
+	t, err := p.dataType()
+	if err != nil {
+		return nil, fmt.Errorf("data type: %w", err)
+	}
+	j.Append(t)
+
+	return j, nil
+}
+
+func (p *Parser) dropColumnDefinition() (Layout, error) {
+	j := Juxtaposition{}
+
+	v, err := p.accept(keyword, "DROP")
+	if err != nil {
+		return nil, err
+	}
+	j.Append(v)
+
+	if v, err := p.accept(keyword, "COLUMN"); err == nil {
+		j.Append(v)
+	}
+
+	if e, err := p.ifExists(); err == nil {
+		j.Append(e)
+	}
+
+	n, err := p.columnName()
+	if err != nil {
+		return nil, fmt.Errorf("column name: %w", err)
+	}
+	j.Append(n)
+
+	if b, err := p.dropBehavior(); err == nil {
+		j.Append(b)
+	}
+
+	return j, nil
+}
+
+func (p *Parser) addTableConstraintDefinition() (Layout, error) {
+	j := Juxtaposition{}
+
+	v, err := p.accept(keyword, "ADD")
+	if err != nil {
+		return nil, err
+	}
+	j.Append(v)
+
+	c, err := p.tableConstraintDefinition()
+	if err != nil {
+		return nil, fmt.Errorf("table constraint definition: %w", err)
+	}
+	j.Append(c)
+
+	return j, nil
+}
+
+func (p *Parser) dropTableConstraintDefinition() (Layout, error) {
+	j := Juxtaposition{}
+
+	v, err := p.accept(keyword, "DROP")
+	if err != nil {
+		return nil, err
+	}
+	j.Append(v)
+
+	v, err = p.accept(keyword, "CONSTRAINT")
+	if err != nil {
+		return nil, err
+	}
+	j.Append(v)
+
+	if e, err := p.ifExists(); err == nil {
+		j.Append(e)
+	}
+
+	n, err := p.identifierChain()
+	if err != nil {
+		return nil, fmt.Errorf("constraint name: %w", err)
+	}
+	j.Append(n)
+
+	if b, err := p.dropBehavior(); err == nil {
+		j.Append(b)
+	}
+
+	return j, nil
+}
+
+func (p *Parser) renameDefinition() (Layout, error) {
+	j := Juxtaposition{}
+
+	v, err := p.accept(keyword, "RENAME")
+	if err != nil {
+		return nil, err
+	}
+	j.Append(v)
+
+	if v, err := p.accept(keyword, "TO"); err == nil {
+		j.Append(v)
+
+		n, err := p.tableName()
+		if err != nil {
+			return nil, fmt.Errorf("table name: %w", err)
+		}
+		j.Append(n)
+
+		return j, nil
+	}
+
+	if v, err := p.accept(keyword, "COLUMN", "CONSTRAINT"); err == nil {
+		j.Append(v)
+	}
+
+	n, err := p.identifierChain()
+	if err != nil {
+		return nil, fmt.Errorf("column name: %w", err)
+	}
+	j.Append(n)
+
+	v, err = p.accept(keyword, "TO")
+	if err != nil {
+		return nil, err
+	}
+	j.Append(v)
+
+	n, err = p.identifierChain()
+	if err != nil {
+		return nil, fmt.Errorf("column name: %w", err)
+	}
+	j.Append(n)
+
+	return j, nil
+}
+
+func (p *Parser) dropTableStatement() (Layout, error) {
+	j := Juxtaposition{}
+
+	v, err := p.accept(keyword, "DROP")
+	if err != nil {
+		return nil, err
+	}
+	j.Append(v)
+
+	v, err = p.accept(keyword, "TABLE")
+	if err != nil {
+		return nil, err
+	}
+	j.Append(v)
+
+	if e, err := p.ifExists(); err == nil {
+		j.Append(e)
+	}
+
+	l, err := p.tableNameList()
+	if err != nil {
+		return nil, fmt.Errorf("table name list: %w", err)
+	}
+	j.Append(l)
+
+	if b, err := p.dropBehavior(); err == nil {
+		j.Append(b)
+	}
+
+	return j, nil
+}
+
+func (p *Parser) tableNameList() (Layout, error) {
+	j := Juxtaposition{}
+
+	n, err := p.tableName()
+	if err != nil {
+		return nil, fmt.Errorf("table name: %w", err)
+	}
+
+	for {
+		v, err := p.accept(comma)
+		if err != nil {
+			break
+		}
+		j.Append(Concatenation{n, v})
+
+		n, err = p.tableName()
+		if err != nil {
+			return nil, fmt.Errorf("table name: %w", err)
+		}
+	}
+	j.Append(n)
+
+	return j, nil
+}
+
+func (p *Parser) ifExists() (Layout, error) {
+	j := Juxtaposition{}
+
+	v, err := p.accept(keyword, "IF")
+	if err != nil {
+		return nil, err
+	}
+	j.Append(v)
+
+	v, err = p.accept(keyword, "EXISTS")
+	if err != nil {
+		return nil, err
+	}
+	j.Append(v)
+
+	return j, nil
+}
+
+func (p *Parser) dropBehavior() (Layout, error) {
+	v, err := p.accept(keyword, "CASCADE", "RESTRICT")
+	if err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+func (p *Parser) truncateTableStatement() (Layout, error) {
+	j := Juxtaposition{}
+
+	v, err := p.accept(keyword, "TRUNCATE")
+	if err != nil {
+		return nil, err
+	}
+	j.Append(v)
+
+	if v, err := p.accept(keyword, "TABLE"); err == nil {
+		j.Append(v)
+	}
+
+	l, err := p.tableNameList()
+	if err != nil {
+		return nil, fmt.Errorf("table name list: %w", err)
+	}
+	j.Append(l)
+
+	if o, err := p.identityColumnRestartOption(); err == nil {
+		j.Append(o)
+	}
+
+	if b, err := p.dropBehavior(); err == nil {
+		j.Append(b)
+	}
+
+	return j, nil
+}
+
+func (p *Parser) identityColumnRestartOption() (Layout, error) {
+	j := Juxtaposition{}
+
+	v, err := p.accept(keyword, "CONTINUE", "RESTART")
+	if err != nil {
+		return nil, err
+	}
+	j.Append(v)
+
+	v, err = p.accept(keyword, "IDENTITY")
+	if err != nil {
+		return nil, err
+	}
+	j.Append(v)
+
+	return j, nil
+}
+
+func (p *Parser) sqlTransactionStatement() (Layout, error) {
+	if _, err := p.expect(keyword, "START", "BEGIN"); err == nil {
+		return p.startTransactionStatement()
+	}
+	if _, err := p.expect(keyword, "COMMIT"); err == nil {
+		return p.commitStatement()
+	}
+	if _, err := p.expect(keyword, "ROLLBACK"); err == nil {
+		return p.rollbackStatement()
+	}
+	if _, err := p.expect(keyword, "SAVEPOINT"); err == nil {
+		return p.savepointStatement()
+	}
+	return p.releaseSavepointStatement()
+}
+
+func (p *Parser) startTransactionStatement() (Layout, error) {
+	j := Juxtaposition{}
+
+	if v, err := p.accept(keyword, "BEGIN"); err == nil {
+		j.Append(v)
+
+		if v, err := p.accept(keyword, "WORK", "TRANSACTION"); err == nil {
+			j.Append(v)
+		}
+	} else {
+		v, err := p.accept(keyword, "START")
+		if err != nil {
+			return nil, err
+		}
+		j.Append(v)
+
+		v, err = p.accept(keyword, "TRANSACTION")
+		if err != nil {
+			return nil, err
+		}
+		j.Append(v)
+	}
+
+	if m, err := p.transactionModeList(); err == nil {
+		j.Append(m)
+	}
+
+	return j, nil
+}
+
+func (p *Parser) transactionModeList() (Layout, error) {
+	j := Juxtaposition{}
+
+	m, err := p.transactionMode()
+	if err != nil {
+		return nil, fmt.Errorf("transaction mode: %w", err)
+	}
+
+	for {
+		v, err := p.accept(comma)
+		if err != nil {
+			break
+		}
+		j.Append(Concatenation{m, v})
+
+		m, err = p.transactionMode()
+		if err != nil {
+			return nil, fmt.Errorf("transaction mode: %w", err)
+		}
+	}
+	j.Append(m)
+
+	return j, nil
+}
+
+func (p *Parser) transactionMode() (Layout, error) {
+	if l, err := p.isolationLevel(); err == nil {
+		return l, nil
+	}
+
+	if m, err := p.transactionAccessMode(); err == nil {
+		return m, nil
+	}
+
+	return p.diagnosticsSize()
+}
+
+func (p *Parser) isolationLevel() (Layout, error) {
+	j := Juxtaposition{}
+
+	v, err := p.accept(keyword, "ISOLATION")
+	if err != nil {
+		return nil, err
+	}
+	j.Append(v)
+
+	v, err = p.accept(keyword, "LEVEL")
+	if err != nil {
+		return nil, err
+	}
+	j.Append(v)
+
+	l, err := p.levelOfIsolation()
+	if err != nil {
+		return nil, fmt.Errorf("level of isolation: %w", err)
+	}
+	j.Append(l)
+
+	return j, nil
+}
+
+func (p *Parser) levelOfIsolation() (Layout, error) {
+	j := Juxtaposition{}
+
+	if v, err := p.accept(keyword, "SERIALIZABLE"); err == nil {
+		j.Append(v)
+		return j, nil
+	}
+
+	if v, err := p.accept(keyword, "REPEATABLE"); err == nil {
+		j.Append(v)
+
+		v, err := p.accept(keyword, "READ")
+		if err != nil {
+			return nil, err
+		}
+		j.Append(v)
+
+		return j, nil
+	}
+
+	v, err := p.accept(keyword, "READ")
+	if err != nil {
+		return nil, err
+	}
+	j.Append(v)
+
+	v, err = p.accept(keyword, "UNCOMMITTED", "COMMITTED")
+	if err != nil {
+		return nil, err
+	}
+	j.Append(v)
+
+	return j, nil
+}
+
+func (p *Parser) transactionAccessMode() (Layout, error) {
+	j := Juxtaposition{}
+
+	v, err := p.accept(keyword, "READ")
+	if err != nil {
+		return nil, err
+	}
+	j.Append(v)
+
+	v, err = p.accept(keyword, "ONLY", "WRITE")
+	if err != nil {
+		return nil, err
+	}
+	j.Append(v)
+
+	return j, nil
+}
+
+func (p *Parser) diagnosticsSize() (Layout, error) {
+	j := Juxtaposition{}
+
+	v, err := p.accept(keyword, "DIAGNOSTICS")
+	if err != nil {
+		return nil, err
+	}
+	j.Append(v)
+
+	v, err = p.accept(keyword, "SIZE")
+	if err != nil {
+		return nil, err
+	}
+	j.Append(v)
+
+	n, err := p.simpleValueSpecification()
+	if err != nil {
+		return nil, fmt.Errorf("number of conditions: %w", err)
+	}
+	j.Append(n)
+
+	return j, nil
+}
+
+func (p *Parser) commitStatement() (Layout, error) {
+	j := Juxtaposition{}
+
+	v, err := p.accept(keyword, "COMMIT")
+	if err != nil {
+		return nil, err
+	}
+	j.Append(v)
+
+	if v, err := p.accept(keyword, "WORK", "TRANSACTION"); err == nil {
+		j.Append(v)
+	}
+
+	if c, err := p.chain(); err == nil {
+		j.Append(c)
+	}
+
+	return j, nil
+}
+
+func (p *Parser) rollbackStatement() (Layout, error) {
+	j := Juxtaposition{}
+
+	v, err := p.accept(keyword, "ROLLBACK")
+	if err != nil {
+		return nil, err
+	}
+	j.Append(v)
+
+	if v, err := p.accept(keyword, "WORK", "TRANSACTION"); err == nil {
+		j.Append(v)
+	}
+
+	if c, err := p.chain(); err == nil {
+		j.Append(c)
+	}
+
+	if s, err := p.savepointClause(); err == nil {
+		j.Append(s)
+	}
+
+	return j, nil
+}
+
+func (p *Parser) chain() (Layout, error) {
+	j := Juxtaposition{}
+
+	v, err := p.accept(keyword, "AND")
+	if err != nil {
+		return nil, err
+	}
+	j.Append(v)
+
+	if v, err := p.accept(keyword, "NO"); err == nil {
+		j.Append(v)
+	}
+
+	v, err = p.accept(keyword, "CHAIN")
+	if err != nil {
+		return nil, err
+	}
+	j.Append(v)
+
+	return j, nil
+}
+
+func (p *Parser) savepointClause() (Layout, error) {
+	j := Juxtaposition{}
+
+	v, err := p.accept(keyword, "TO")
+	if err != nil {
+		return nil, err
+	}
+	j.Append(v)
+
+	if v, err := p.accept(keyword, "SAVEPOINT"); err == nil {
+		j.Append(v)
+	}
+
+	n, err := p.savepointSpecifier()
+	if err != nil {
+		return nil, fmt.Errorf("savepoint specifier: %w", err)
+	}
+	j.Append(n)
+
+	return j, nil
+}
+
+func (p *Parser) savepointStatement() (Layout, error) {
+	j := Juxtaposition{}
+
+	v, err := p.accept(keyword, "SAVEPOINT")
+	if err != nil {
+		return nil, err
+	}
+	j.Append(v)
+
+	n, err := p.savepointSpecifier()
+	if err != nil {
+		return nil, fmt.Errorf("savepoint specifier: %w", err)
+	}
+	j.Append(n)
+
+	return j, nil
+}
+
+func (p *Parser) releaseSavepointStatement() (Layout, error) {
+	j := Juxtaposition{}
+
+	v, err := p.accept(keyword, "RELEASE")
+	if err != nil {
+		return nil, err
+	}
+	j.Append(v)
+
+	if v, err := p.accept(keyword, "SAVEPOINT"); err == nil {
+		j.Append(v)
+	}
+
+	n, err := p.savepointSpecifier()
+	if err != nil {
+		return nil, fmt.Errorf("savepoint specifier: %w", err)
+	}
+	j.Append(n)
+
+	return j, nil
+}
+
+func (p *Parser) savepointSpecifier() (Layout, error) {
+	v, err := p.accept(identifier)
+	if err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+func (p *Parser) sqlSessionStatement() (Layout, error) {
+	if _, err := p.expect(keyword, "SHOW"); err == nil {
+		return p.showStatement()
+	}
+	if _, err := p.expect(keyword, "RESET"); err == nil {
+		return p.resetStatement()
+	}
+	if s, err := p.try(p.setTransactionStatement); err == nil {
+		return s, nil
+	}
+	return p.setStatement()
+}
+
+func (p *Parser) setTransactionStatement() (Layout, error) {
+	j := Juxtaposition{}
+
+	v, err := p.accept(keyword, "SET")
+	if err != nil {
+		return nil, err
+	}
+	j.Append(v)
+
+	if v, err := p.accept(keyword, "LOCAL", "SESSION"); err == nil {
+		j.Append(v)
+	}
+
+	v, err = p.accept(keyword, "TRANSACTION")
+	if err != nil {
+		return nil, err
+	}
+	j.Append(v)
+
+	m, err := p.transactionModeList()
+	if err != nil {
+		return nil, fmt.Errorf("transaction mode list: %w", err)
+	}
+	j.Append(m)
+
+	return j, nil
+}
+
+// setStatement parses a SET statement for a session parameter such as SET search_path = s, public
+// or SET LOCAL statement_timeout TO '5s'.
+func (p *Parser) setStatement() (Layout, error) {
+	j := Juxtaposition{}
+
+	v, err := p.accept(keyword, "SET")
+	if err != nil {
+		return nil, err
+	}
+	j.Append(v)
+
+	if v, err := p.accept(keyword, "LOCAL", "SESSION"); err == nil {
+		j.Append(v)
+	}
+
+	if v, err := p.accept(keyword, "TIME"); err == nil {
+		j.Append(v)
+
+		v, err := p.accept(keyword, "ZONE")
+		if err != nil {
+			return nil, err
+		}
+		j.Append(v)
+
+		z, err := p.parameterValue()
+		if err != nil {
+			return nil, fmt.Errorf("time zone: %w", err)
+		}
+		j.Append(z)
+
+		return j, nil
+	}
+
+	n, err := p.parameterName()
+	if err != nil {
+		return nil, fmt.Errorf("parameter name: %w", err)
+	}
+	j.Append(n)
+
+	if v, err := p.accept(equalsOperator); err == nil {
+		j.Append(v)
+	} else if v, err := p.accept(keyword, "TO"); err == nil {
+		j.Append(v)
+	}
+
+	l, err := p.parameterValueList()
+	if err != nil {
+		return nil, fmt.Errorf("parameter value list: %w", err)
+	}
+	j.Append(l)
+
+	return j, nil
+}
+
+func (p *Parser) showStatement() (Layout, error) {
+	j := Juxtaposition{}
+
+	v, err := p.accept(keyword, "SHOW")
+	if err != nil {
+		return nil, err
+	}
+	j.Append(v)
+
+	n, err := p.parameterName()
+	if err != nil {
+		return nil, fmt.Errorf("parameter name: %w", err)
+	}
+	j.Append(n)
+
+	return j, nil
+}
+
+func (p *Parser) resetStatement() (Layout, error) {
+	j := Juxtaposition{}
+
+	v, err := p.accept(keyword, "RESET")
+	if err != nil {
+		return nil, err
+	}
+	j.Append(v)
+
+	n, err := p.parameterName()
+	if err != nil {
+		return nil, fmt.Errorf("parameter name: %w", err)
+	}
+	j.Append(n)
+
+	return j, nil
+}
+
+// parameterName accepts keywords as well since parameters like ROLE, SCHEMA or ALL are keywords.
+func (p *Parser) parameterName() (Layout, error) {
+	if v, err := p.accept(keyword); err == nil {
+		return v, nil
+	}
+	return p.identifierChain()
+}
+
+func (p *Parser) parameterValueList() (Layout, error) {
+	j := Juxtaposition{}
+
+	v, err := p.parameterValue()
+	if err != nil {
+		return nil, fmt.Errorf("parameter value: %w", err)
+	}
+
+	for {
+		c, err := p.accept(comma)
+		if err != nil {
+			break
+		}
+		j.Append(Concatenation{v, c})
+
+		v, err = p.parameterValue()
+		if err != nil {
+			return nil, fmt.Errorf("parameter value: %w", err)
+		}
+	}
+	j.Append(v)
+
+	return j, nil
+}
+
+func (p *Parser) parameterValue() (Layout, error) {
+	if v, err := p.accept(characterString); err == nil {
+		return v, nil
+	}
+
+	if n, err := p.signedNumericLiteral(); err == nil {
+		return n, nil
+	}
+
+	if v, err := p.accept(keyword); err == nil {
+		return v, nil
+	}
+
+	return p.identifierChain()
+}
+
+func (p *Parser) directSQLDataStatement() (Layout, error) {
+	if _, err := p.expect(keyword, "INSERT"); err == nil {
+		return p.insertStatement()
+	}
+	if _, err := p.expect(keyword, "UPDATE"); err == nil {
+		return p.updateStatement()
+	}
+	if _, err := p.expect(keyword, "DELETE"); err == nil {
+		return p.deleteStatement()
+	}
+	if _, err := p.expect(keyword, "MERGE"); err == nil {
+		return p.mergeStatement()
+	}
+	if _, err := p.expect(keyword, "TRUNCATE"); err == nil {
+		return p.truncateTableStatement()
+	}
+	return p.cursorSpecification()
+}
+
+func (p *Parser) insertStatement() (Layout, error) {
+	j := Juxtaposition{}
+
+	v, err := p.accept(keyword, "INSERT")
+	if err != nil {
+		return nil, err
+	}
+	j.Append(v)
+
+	v, err = p.accept(keyword, "INTO")
+	if err != nil {
+		return nil, err
+	}
+	j.Append(v)
+
+	t, err := p.insertionTarget()
+	if err != nil {
+		return nil, fmt.Errorf("insertion target: %w", err)
+	}
+	j.Append(t)
+
+	if d, err := p.fromDefault(); err == nil {
+		j.Append(d)
+		return j, nil
+	}
+
+	if l, err := p.try(p.parenthesizedInsertColumnList); err == nil {
+		j.Append(l)
+	}
+
+	if o, err := p.overrideClause(); err == nil {
+		j.Append(o)
+	}
+
+	q, err := p.queryExpression()
+	if err != nil {
+		return nil, fmt.Errorf("query expression: %w", err)
+	}
+
+	// a query with a wider river, e.g. a parenthesized one, is indented rather than pushing INSERT to the right.
+	if q.Gutter() > j.Gutter() {
+		return Stack{j, Indent{q}}, nil
+	}
+
+	s := Stack{}
+	s.Append(j, q)
+
+	s.AlignGutter()
+
+	return s, nil
+}
+
+func (p *Parser) insertionTarget() (Layout, error) {
+	return p.tableName()
+}
+
+func (p *Parser) fromDefault() (Layout, error) {
+	j := Juxtaposition{}
+
+	v, err := p.accept(keyword, "DEFAULT")
+	if err != nil {
+		return nil, err
+	}
+	j.Append(v)
+
+	v, err = p.accept(keyword, "VALUES")
+	if err != nil {
+		return nil, err
+	}
+	j.Append(v)
+
+	return j, nil
+}
+
+func (p *Parser) parenthesizedInsertColumnList() (Layout, error) {
+	if _, err := p.accept(leftParen); err != nil {
+		return nil, err
+	}
+
+	var ns []Layout
+
+	n, err := p.columnName()
+	if err != nil {
+		return nil, fmt.Errorf("column name: %w", err)
+	}
+	ns = append(ns, n)
+
+	for {
+		if _, err := p.accept(comma); err != nil {
+			break
+		}
+
+		n, err := p.columnName()
+		if err != nil {
+			return nil, fmt.Errorf("column name: %w", err)
+		}
+		ns = append(ns, n)
+	}
+
+	if _, err := p.accept(rightParen); err != nil {
+		return nil, err
+	}
+
+	l := wrappedList(ns)
+
+	return Choice{
+		One:     Concatenation{Atom("("), l.One, Atom(")")},
+		Another: Concatenation{Atom("("), l.Another, Atom(")")},
+	}, nil
+}
+
+// wrappedList separates ls with commas in a line if it fits, otherwise one per line.
+func wrappedList(ls []Layout) Choice {
+	j := Juxtaposition{}
+	s := Stack{}
+	for i, l := range ls {
+		if i < len(ls)-1 {
+			l = Concatenation{l, Atom(",")}
+		}
+		j = append(j, l)
+		s = append(s, l)
+	}
+	return Choice{One: j, Another: s}
+}
+
+func (p *Parser) updateStatement() (Layout, error) {
+	s := Stack{}
+
+	j := Juxtaposition{}
+
+	v, err := p.accept(keyword, "UPDATE")
+	if err != nil {
+		return nil, err
+	}
+	j.Append(v)
+
+	t, err := p.targetTable()
+	if err != nil {
+		return nil, fmt.Errorf("target table: %w", err)
+	}
+	j.Append(t)
+
+	if c, err := p.targetCorrelation(); err == nil {
+		j.Append(c)
+	}
+
+	s.Append(j)
+
+	j = Juxtaposition{}
+
+	v, err = p.accept(keyword, "SET")
+	if err != nil {
+		return nil, err
+	}
+	j.Append(v)
+
+	l, err := p.setClauseList()
+	if err != nil {
+		return nil, fmt.Errorf("set clause list: %w", err)
+	}
+	j.Append(l)
+
+	s.Append(j)
+
+	if f, err := p.fromClause(); err == nil {
+		s.Append(f)
+	}
+
+	if w, err := p.try(p.whereCurrentOf); err == nil {
+		s.Append(w)
+	} else if w, err := p.whereClause(); err == nil {
+		s.Append(w)
+	}
+
+	s.AlignGutter()
+
+	return s, nil
+}
+
+func (p *Parser) deleteStatement() (Layout, error) {
+	s := Stack{}
+
+	j := Juxtaposition{}
+
+	v, err := p.accept(keyword, "DELETE")
+	if err != nil {
+		return nil, err
+	}
+	j.Append(v)
+
+	v, err = p.accept(keyword, "FROM")
+	if err != nil {
+		return nil, err
+	}
+	j.Append(v)
+
+	t, err := p.targetTable()
+	if err != nil {
+		return nil, fmt.Errorf("target table: %w", err)
+	}
+	j.Append(t)
+
+	if c, err := p.targetCorrelation(); err == nil {
+		j.Append(c)
+	}
+
+	s.Append(j)
+
+	if w, err := p.try(p.whereCurrentOf); err == nil {
+		s.Append(w)
+	} else if w, err := p.whereClause(); err == nil {
+		s.Append(w)
+	}
+
+	s.AlignGutter()
+
+	return s.Strip(), nil
+}
+
+func (p *Parser) mergeStatement() (Layout, error) {
+	s := Stack{}
+
+	j := Juxtaposition{}
+
+	v, err := p.accept(keyword, "MERGE")
+	if err != nil {
+		return nil, err
+	}
+	j.Append(v)
+
+	v, err = p.accept(keyword, "INTO")
+	if err != nil {
+		return nil, err
+	}
+	j.Append(v)
+
+	t, err := p.targetTable()
+	if err != nil {
+		return nil, fmt.Errorf("target table: %w", err)
+	}
+	j.Append(t)
+
+	if c, err := p.targetCorrelation(); err == nil {
+		j.Append(c)
+	}
+
+	s.Append(j)
+
+	j = Juxtaposition{}
+
+	v, err = p.accept(keyword, "USING")
+	if err != nil {
+		return nil, err
+	}
+	j.Append(v)
+
+	r, err := p.tableReference()
+	if err != nil {
+		return nil, fmt.Errorf("table reference: %w", err)
+	}
+	j.Append(r)
+
+	s.Append(j)
+
+	v, err = p.accept(keyword, "ON")
+	if err != nil {
+		return nil, err
+	}
+
+	c, err := p.searchCondition()
+	if err != nil {
+		return nil, fmt.Errorf("search condition: %w", err)
+	}
+
+	if l, ok := c.(Stack); ok {
+		j := Juxtaposition{}
+		j.Append(v, l[0])
+		l[0] = j
+		s.Append(l)
+	} else {
+		j := Juxtaposition{}
+		j.Append(v, c)
+		s.Append(j)
+	}
+
+	o, err := p.mergeOperationSpecification()
+	if err != nil {
+		return nil, fmt.Errorf("merge operation specification: %w", err)
+	}
+	s.Append(o)
+
+	s.AlignGutter()
+
+	return s, nil
+}
+
+func (p *Parser) mergeOperationSpecification() (Layout, error) {
+	s := Stack{}
+
+	w, err := p.mergeWhenClause()
+	if err != nil {
+		return nil, fmt.Errorf("merge when clause: %w", err)
+	}
+	s.Append(w)
+
+	for {
+		if _, err := p.expect(keyword, "WHEN"); err != nil {
+			break
+		}
+
+		w, err := p.mergeWhenClause()
+		if err != nil {
+			return nil, fmt.Errorf("merge when clause: %w", err)
+		}
+		s.Append(w)
+	}
+
+	return s, nil
+}
+
+func (p *Parser) mergeWhenClause() (Layout, error) {
+	j := Juxtaposition{}
+
+	v, err := p.accept(keyword, "WHEN")
+	if err != nil {
+		return nil, err
+	}
+	j.Append(v)
+
+	matched := true
+	if v, err := p.accept(keyword, "NOT"); err == nil {
+		j.Append(v)
+		matched = false
+	}
+
+	v, err = p.accept(keyword, "MATCHED")
+	if err != nil {
+		return nil, err
+	}
+	j.Append(v)
+
+	if v, err := p.accept(keyword, "AND"); err == nil {
+		j.Append(v)
+
+		c, err := p.searchCondition()
+		if err != nil {
+			return nil, fmt.Errorf("search condition: %w", err)
+		}
+		j.Append(c)
+	}
+
+	v, err = p.accept(keyword, "THEN")
+	if err != nil {
+		return nil, err
+	}
+	j.Append(v)
+
+	var o Layout
+	if matched {
+		o, err = p.mergeUpdateOrDeleteSpecification()
+	} else {
+		o, err = p.mergeInsertSpecification()
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return Stack{j, Indent{o}}, nil
+}
+
+func (p *Parser) mergeUpdateOrDeleteSpecification() (Layout, error) {
+	if v, err := p.accept(keyword, "DELETE"); err == nil {
+		return v, nil
+	}
+
+	o, err := p.mergeUpdateSpecification()
+	if err != nil {
+		return nil, fmt.Errorf("merge update specification: %w", err)
+	}
+	return o, nil
+}
+
+func (p *Parser) mergeUpdateSpecification() (Layout, error) {
+	s := Stack{}
+
+	v, err := p.accept(keyword, "UPDATE")
+	if err != nil {
+		return nil, err
+	}
+	s.Append(Juxtaposition{v})
+
+	j := Juxtaposition{}
+
+	v, err = p.accept(keyword, "SET")
+	if err != nil {
+		return nil, err
+	}
+	j.Append(v)
+
+	l, err := p.setClauseList()
+	if err != nil {
+		return nil, fmt.Errorf("set clause list: %w", err)
+	}
+	j.Append(l)
+
+	s.Append(j)
+
+	s.AlignGutter()
+
+	return s, nil
+}
+
+func (p *Parser) mergeInsertSpecification() (Layout, error) {
+	s := Stack{}
+
+	j := Juxtaposition{}
+
+	v, err := p.accept(keyword, "INSERT")
+	if err != nil {
+		return nil, err
+	}
+	j.Append(v)
+
+	if l, err := p.try(p.parenthesizedInsertColumnList); err == nil {
+		j.Append(l)
+	}
+
+	if o, err := p.overrideClause(); err == nil {
+		j.Append(o)
+	}
+
+	s.Append(j)
+
+	t, err := p.tableValueConstructor()
+	if err != nil {
+		return nil, fmt.Errorf("merge insert value list: %w", err)
+	}
+	s.Append(t)
+
+	s.AlignGutter()
+
+	return s, nil
+}
+
+func (p *Parser) targetTable() (Layout, error) {
+	return p.tableName()
+}
+
+func (p *Parser) targetCorrelation() (Layout, error) {
+	j := Juxtaposition{}
+
+	if v, err := p.accept(keyword, "AS"); err == nil {
+		j.Append(v)
+	}
+
+	c, err := p.correlationName()
+	if err != nil {
+		return nil, err
+	}
+	j.Append(c)
+
+	return j, nil
+}
+
+func (p *Parser) whereCurrentOf() (Layout, error) {
+	j := Juxtaposition{}
+
+	v, err := p.accept(keyword, "WHERE")
+	if err != nil {
+		return nil, err
+	}
+	j.Append(v)
+
+	v, err = p.accept(keyword, "CURRENT")
+	if err != nil {
+		return nil, err
+	}
+	j.Append(v)
+
+	v, err = p.accept(keyword, "OF")
+	if err != nil {
+		return nil, err
+	}
+	j.Append(v)
+
+	n, err := p.cursorName()
+	if err != nil {
+		return nil, fmt.Errorf("cursor name: %w", err)
+	}
+	j.Append(n)
+
+	return j, nil
+}
+
+func (p *Parser) cursorName() (Layout, error) {
+	v, err := p.accept(identifier)
+	if err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+func (p *Parser) setClauseList() (Layout, error) {
+	s := Stack{}
+
+	c, err := p.setClause()
+	if err != nil {
+		return nil, fmt.Errorf("set clause: %w", err)
+	}
+
+	for {
+		v, err := p.accept(comma)
+		if err != nil {
+			break
+		}
+		s.Append(Concatenation{c, v})
+
+		c, err = p.setClause()
+		if err != nil {
+			return nil, fmt.Errorf("set clause: %w", err)
+		}
+	}
+	s.Append(c)
+
+	return s.Strip(), nil
+}
+
+func (p *Parser) setClause() (Layout, error) {
+	if c, err := p.try(p.multipleColumnAssignment); err == nil {
+		return c, nil
+	}
+
+	j := Juxtaposition{}
+
+	t, err := p.setTarget()
+	if err != nil {
+		return nil, fmt.Errorf("set target: %w", err)
+	}
+	j.Append(t)
+
+	v, err := p.accept(equalsOperator)
+	if err != nil {
+		return nil, err
+	}
+	j.Append(v)
+
+	u, err := p.updateSource()
+	if err != nil {
+		return nil, fmt.Errorf("update source: %w", err)
+	}
+	j.Append(u)
+
+	return j, nil
+}
+
+func (p *Parser) setTarget() (Layout, error) {
+	return p.columnName()
+}
+
+func (p *Parser) multipleColumnAssignment() (Layout, error) {
+	j := Juxtaposition{}
+
+	c := Concatenation{}
+
+	v, err := p.accept(leftParen)
+	if err != nil {
+		return nil, err
+	}
+	c.Append(v)
+
+	l, err := p.columnNameList()
+	if err != nil {
+		return nil, fmt.Errorf("set target list: %w", err)
+	}
+	c.Append(l)
+
+	v, err = p.accept(rightParen)
+	if err != nil {
+		return nil, err
+	}
+	c.Append(v)
+
+	j.Append(c)
+
+	v, err = p.accept(equalsOperator)
+	if err != nil {
+		return nil, err
+	}
+	j.Append(v)
+
+	r, err := p.tableRowValueExpression()
+	if err != nil {
+		return nil, fmt.Errorf("assigned row: %w", err)
+	}
+	j.Append(r)
+
+	return j, nil
+}
+
+func (p *Parser) updateSource() (Layout, error) {
+	if v, err := p.accept(keyword, "DEFAULT"); err == nil {
+		return v, nil
+	}
+	return p.valueExpression()
+}
+
+func (p *Parser) overrideClause() (Layout, error) {
+	j := Juxtaposition{}
+
+	v, err := p.accept(keyword, "OVERRIDING")
+	if err != nil {
+		return nil, err
+	}
+	j.Append(v)
+
+	v, err = p.accept(keyword, "USER", "SYSTEM")
+	if err != nil {
+		return nil, err
+	}
+	j.Append(v)
+
+	v, err = p.accept(keyword, "VALUE")
+	if err != nil {
+		return nil, err
+	}
+	j.Append(v)
+
+	return j, nil
+}
+
+func (p *Parser) cursorSpecification() (Layout, error) {
+	return p.queryExpression()
+}
+
+func (p *Parser) queryExpression() (Layout, error) {
+	s := Stack{}
+
+	if w, err := p.withClause(); err == nil {
+		s.Append(w)
+	}
+
+	b, err := p.queryExpressionBody()
+	if err != nil {
+		return nil, fmt.Errorf("query expression body: %w", err)
+	}
+	s.Append(b)
+
+	if o, err := p.orderByClause(); err == nil {
+		s.Append(o)
+	}
+
+	if o, err := p.resultOffsetClause(); err == nil {
+		s.Append(o)
+	}
+
+	if f, err := p.fetchFirstClause(); err == nil {
+		s.Append(f)
+	}
+
+	s.AlignGutter()
+
+	return s, nil
+}
+
+func (p *Parser) withClause() (Layout, error) {
+	j := Juxtaposition{}
+
+	v, err := p.accept(keyword, "WITH")
+	if err != nil {
+		return nil, err
+	}
+	j.Append(v)
+
+	if v, err := p.accept(keyword, "RECURSIVE"); err == nil {
+		j.Append(v)
+	}
+
+	l, err := p.withList()
+	if err != nil {
+		return nil, fmt.Errorf("with list: %w", err)
+	}
+	j.Append(l)
+
+	return j, nil
+}
+
+func (p *Parser) withList() (Layout, error) {
+	s := Stack{}
+
+	e, err := p.withListElement()
+	if err != nil {
+		return nil, fmt.Errorf("with list element: %w", err)
+	}
+
+	for {
+		v, err := p.accept(comma)
+		if err != nil {
+			break
+		}
+		s = append(s, Concatenation{e, v})
+
+		e, err = p.withListElement()
+		if err != nil {
+			return nil, fmt.Errorf("with list element: %w", err)
+		}
+	}
+	s = append(s, e)
+
+	return s.Strip(), nil
+}
+
+func (p *Parser) withListElement() (Layout, error) {
+	j := Juxtaposition{}
+
+	n, err := p.queryName()
+	if err != nil {
+		return nil, fmt.Errorf("query name: %w", err)
+	}
+	j.Append(n)
+
+	if l, err := p.parenthesizedWithColumnList(); err == nil {
+		j.Append(l)
+	}
+
+	v, err := p.accept(keyword, "AS")
+	if err != nil {
+		return nil, err
+	}
+	j.Append(v)
+
+	// the parentheses of the table subquery are taken apart so that the query is indented beneath the name.
+	v, err = p.accept(leftParen)
+	if err != nil {
+		return nil, err
+	}
+	j.Append(v)
+
+	q, err := p.queryExpression()
+	if err != nil {
+		return nil, fmt.Errorf("query expression: %w", err)
+	}
+
+	v, err = p.accept(rightParen)
+	if err != nil {
+		return nil, err
+	}
+
+	s := Stack{j, Indent{q}, v}
+
+	if c, err := p.searchClause(); err == nil {
+		s.Append(c)
+	}
+
+	if c, err := p.cycleClause(); err == nil {
+		s.Append(c)
+	}
+
+	return s.Strip(), nil
+}
+
+func (p *Parser) queryName() (Layout, error) {
+	v, err := p.accept(identifier)
+	if err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+func (p *Parser) parenthesizedWithColumnList() (Layout, error) {
+	c := Concatenation{}
+
+	v, err := p.accept(leftParen)
+	if err != nil {
+		return nil, err
+	}
+	c.Append(v)
+
+	l, err := p.withColumnList()
+	if err != nil {
+		return nil, fmt.Errorf("with column list: %w", err)
+	}
+	c.Append(l)
+
+	v, err = p.accept(rightParen)
+	if err != nil {
+		return nil, err
+	}
+	c.Append(v)
+
+	return c, nil
+}
+
+func (p *Parser) withColumnList() (Layout, error) {
+	return p.columnNameList()
+}
+
+func (p *Parser) searchClause() (Layout, error) {
+	j := Juxtaposition{}
+
+	v, err := p.accept(keyword, "SEARCH")
+	if err != nil {
+		return nil, err
+	}
+	j.Append(v)
+
+	o, err := p.recursiveSearchOrder()
+	if err != nil {
+		return nil, fmt.Errorf("recursive search order: %w", err)
+	}
+	j.Append(o)
+
+	v, err = p.accept(keyword, "SET")
+	if err != nil {
+		return nil, err
+	}
+	j.Append(v)
+
+	c, err := p.columnName()
+	if err != nil {
+		return nil, fmt.Errorf("sequence column: %w", err)
+	}
+	j.Append(c)
+
+	return j, nil
+}
+
+func (p *Parser) recursiveSearchOrder() (Layout, error) {
+	j := Juxtaposition{}
+
+	v, err := p.accept(keyword, "DEPTH", "BREADTH")
+	if err != nil {
+		return nil, err
+	}
+	j.Append(v)
+
+	v, err = p.accept(keyword, "FIRST")
+	if err != nil {
+		return nil, err
+	}
+	j.Append(v)
+
+	v, err = p.accept(keyword, "BY")
+	if err != nil {
+		return nil, err
+	}
+	j.Append(v)
+
+	l, err := p.columnNameList()
+	if err != nil {
+		return nil, fmt.Errorf("column name list: %w", err)
+	}
+	j.Append(l)
+
+	return j, nil
+}
+
+func (p *Parser) cycleClause() (Layout, error) {
+	j := Juxtaposition{}
+
+	v, err := p.accept(keyword, "CYCLE")
+	if err != nil {
+		return nil, err
+	}
+	j.Append(v)
+
+	l, err := p.columnNameList()
+	if err != nil {
+		return nil, fmt.Errorf("cycle column list: %w", err)
+	}
+	j.Append(l)
+
+	v, err = p.accept(keyword, "SET")
+	if err != nil {
+		return nil, err
+	}
+	j.Append(v)
+
+	c, err := p.columnName()
+	if err != nil {
+		return nil, fmt.Errorf("cycle mark column: %w", err)
+	}
+	j.Append(c)
+
+	if v, err := p.accept(keyword, "TO"); err == nil {
+		j.Append(v)
+
+		m, err := p.valueExpression()
+		if err != nil {
+			return nil, fmt.Errorf("cycle mark value: %w", err)
+		}
+		j.Append(m)
+
+		v, err = p.accept(keyword, "DEFAULT")
+		if err != nil {
+			return nil, err
+		}
+		j.Append(v)
+
+		m, err = p.valueExpression()
+		if err != nil {
+			return nil, fmt.Errorf("non-cycle mark value: %w", err)
+		}
+		j.Append(m)
+	}
+
+	v, err = p.accept(keyword, "USING")
+	if err != nil {
+		return nil, err
+	}
+	j.Append(v)
+
+	c, err = p.columnName()
+	if err != nil {
+		return nil, fmt.Errorf("path column: %w", err)
+	}
+	j.Append(c)
+
+	return j, nil
+}
+
+func (p *Parser) queryExpressionBody() (Layout, error) {
+	s := Stack{}
+
+	q, err := p.queryTerm()
+	if err != nil {
+		return nil, fmt.Errorf("query term: %w", err)
+	}
+	s.Append(q)
+
+	for {
+		j := Juxtaposition{}
+
+		k, err := p.accept(keyword, "UNION", "EXCEPT")
+		if err != nil {
+			break
+		}
+		j.Append(k)
+
+		if k, err := p.accept(keyword, "ALL", "DISTINCT"); err == nil {
+			j.Append(k)
+		}
+
+		if c, err := p.correspondingSpec(); err == nil {
+			j.Append(c)
+		}
+
+		t, err := p.queryTerm()
+		if err != nil {
+			return nil, err
+		}
+
+		s.Append(j, t)
+	}
+
+	// a lone query term has nothing to be set apart from.
+	if i, ok := s[0].(Indent); ok && len(s) == 1 {
+		s = Stack{}
+		s.Append(i.Layout)
+	}
+
+	s.AlignGutter()
+
+	return s, nil
+}
+
+func (p *Parser) queryTerm() (Layout, error) {
+	q, err := p.queryPrimary()
+	if err != nil {
+		return nil, fmt.Errorf("query primary: %w", err)
+	}
+
+	if _, err := p.expect(keyword, "INTERSECT"); err != nil {
+		return q, nil
+	}
+
+	s := Stack{}
+	s.Append(q)
+
+	for {
+		j := Juxtaposition{}
+
+		k, err := p.accept(keyword, "INTERSECT")
+		if err != nil {
+			break
+		}
+		j.Append(k)
+
+		if k, err := p.accept(keyword, "ALL", "DISTINCT"); err == nil {
+			j.Append(k)
+		}
+
+		if c, err := p.correspondingSpec(); err == nil {
+			j.Append(c)
+		}
+
+		q, err := p.queryPrimary()
+		if err != nil {
+			return nil, fmt.Errorf("query primary: %w", err)
+		}
+
+		s.Append(j, q)
+	}
+
+	s.AlignGutter()
+
+	// INTERSECT binds tighter than UNION and EXCEPT so its operands are grouped in an indented block.
+	return Indent{s}, nil
+}
+
+func (p *Parser) queryPrimary() (Layout, error) {
+	if s, err := p.simpleTable(); err == nil {
+		return s, nil
+	}
+
+	c := Concatenation{}
+
+	v, err := p.accept(leftParen)
+	if err != nil {
+		return nil, err
+	}
+	c.Append(v)
+
+	q, err := p.queryExpression()
+	if err != nil {
+		return nil, fmt.Errorf("query expression: %w", err)
+	}
+	c.Append(q)
+
+	v, err = p.accept(rightParen)
+	if err != nil {
+		return nil, err
+	}
+	c.Append(v)
+
+	return c, nil
+}
+
+func (p *Parser) simpleTable() (Layout, error) {
+	if t, err := p.try(p.tableValueConstructor); err == nil {
+		return t, nil
+	}
+	if t, err := p.try(p.explicitTable); err == nil {
+		return t, nil
+	}
+	return p.querySpecification()
+}
+
+func (p *Parser) tableValueConstructor() (Layout, error) {
+	j := Juxtaposition{}
+
+	v, err := p.accept(keyword, "VALUES")
+	if err != nil {
+		return nil, err
+	}
+	j.Append(v)
+
+	l, err := p.rowValueExpressionList()
+	if err != nil {
+		return nil, fmt.Errorf("row value expression list: %w", err)
+	}
+	j.Append(l)
+
+	return j, nil
+}
+
+func (p *Parser) rowValueExpressionList() (Layout, error) {
+	var rs []Layout
+
+	r, err := p.tableRowValueExpression()
+	if err != nil {
+		return nil, fmt.Errorf("table row value expression: %w", err)
+	}
+	rs = append(rs, r)
+
+	for {
+		if _, err := p.accept(comma); err != nil {
+			break
+		}
+
+		r, err := p.tableRowValueExpression()
+		if err != nil {
+			return nil, fmt.Errorf("table row value expression: %w", err)
+		}
+		rs = append(rs, r)
+	}
+
+	s := rowStack(rs)
+	if g, ok := gridRows(rs); ok {
+		return Choice{One: rowStack(g), Another: s}, nil
+	}
+	return s, nil
+}
+
+// rowStack puts each row in its own line.
+func rowStack(rs []Layout) Stack {
+	s := Stack{}
+	for i, r := range rs {
+		if i < len(rs)-1 {
+			r = Concatenation{r, Atom(",")}
+		}
+		s = append(s, r)
+	}
+	return s
+}
+
+// gridRows pads the elements of parenthesized rows so that they line up in columns.
+// It fails if there's only one row or any row isn't a parenthesized list of single-line elements.
+func gridRows(rs []Layout) ([]Layout, bool) {
+	if len(rs) < 2 {
+		return nil, false
+	}
+
+	js := make([]Juxtaposition, len(rs))
+	for i, r := range rs {
+		c, ok := r.(Concatenation)
+		if !ok || len(c) != 3 {
+			return nil, false
+		}
+		j, ok := c[1].(Juxtaposition)
+		if !ok {
+			return nil, false
+		}
+		js[i] = j
+	}
+
+	js, ok := alignColumns(js)
+	if !ok {
+		return nil, false
+	}
+
+	g := make([]Layout, len(rs))
+	for i, r := range rs {
+		c := r.(Concatenation)
+		g[i] = Concatenation{c[0], js[i], c[2]}
+	}
+	return g, true
+}
+
+func (p *Parser) tableRowValueExpression() (Layout, error) {
+	if r, err := p.try(p.explicitRowValueConstructor); err == nil {
+		return r, nil
+	}
+	return p.rowValueExpression()
+}
+
+func (p *Parser) explicitRowValueConstructor() (Layout, error) {
+	c := Concatenation{}
+
+	if v, err := p.accept(keyword, "ROW"); err == nil {
+		c.Append(v)
+	}
+
+	v, err := p.accept(leftParen)
+	if err != nil {
+		return nil, err
+	}
+	c.Append(v)
+
+	l, err := p.rowValueConstructorElementList()
+	if err != nil {
+		return nil, fmt.Errorf("row value constructor element list: %w", err)
+	}
+	c.Append(l)
+
+	v, err = p.accept(rightParen)
+	if err != nil {
+		return nil, err
+	}
+	c.Append(v)
+
+	return c, nil
+}
+
+func (p *Parser) rowValueConstructorElementList() (Layout, error) {
+	j := Juxtaposition{}
+
+	e, err := p.rowValueConstructorElement()
+	if err != nil {
+		return nil, fmt.Errorf("row value constructor element: %w", err)
+	}
+
+	for {
+		v, err := p.accept(comma)
+		if err != nil {
+			break
+		}
+		j.Append(Concatenation{e, v})
+
+		e, err = p.rowValueConstructorElement()
+		if err != nil {
+			return nil, fmt.Errorf("row value constructor element: %w", err)
+		}
+	}
+	j.Append(e)
+
+	return j, nil
+}
+
+// rowValueConstructorElement also accepts DEFAULT as rows of INSERT may leave columns to their defaults.
+func (p *Parser) rowValueConstructorElement() (Layout, error) {
+	if v, err := p.accept(keyword, "DEFAULT"); err == nil {
+		return v, nil
+	}
+	return p.valueExpression()
+}
+
+func (p *Parser) explicitTable() (Layout, error) {
+	j := Juxtaposition{}
+
+	v, err := p.accept(keyword, "TABLE")
+	if err != nil {
+		return nil, err
+	}
+	j.Append(v)
+
+	n, err := p.tableOrQueryName()
+	if err != nil {
+		return nil, fmt.Errorf("table or query name: %w", err)
+	}
+	j.Append(n)
+
+	return j, nil
+}
+
+func (p *Parser) orderByClause() (Layout, error) {
+	j := Juxtaposition{}
+
+	v, err := p.accept(keyword, "ORDER")
+	if err != nil {
+		return nil, err
+	}
+	j.Append(v)
+
+	v, err = p.accept(keyword, "BY")
+	if err != nil {
+		return nil, err
+	}
+	j.Append(v)
+
+	l, err := p.sortSpecificationList()
+	if err != nil {
+		return nil, fmt.Errorf("sort specification list: %w", err)
+	}
+	j.Append(l)
+
+	return j, nil
+}
+
+func (p *Parser) sortSpecificationList() (Layout, error) {
+	j := Juxtaposition{}
+
+	s, err := p.sortSpecification()
+	if err != nil {
+		return nil, fmt.Errorf("sort specification: %w", err)
+	}
+
+	for {
+		v, err := p.accept(comma)
+		if err != nil {
+			break
+		}
+		j.Append(Concatenation{s, v})
+
+		s, err = p.sortSpecification()
+		if err != nil {
+			return nil, fmt.Errorf("sort specification: %w", err)
+		}
+	}
+	j.Append(s)
+
+	return j, nil
+}
+
+func (p *Parser) sortSpecification() (Layout, error) {
+	j := Juxtaposition{}
+
+	k, err := p.sortKey()
+	if err != nil {
+		return nil, fmt.Errorf("sort key: %w", err)
+	}
+	j.Append(k)
+
+	if o, err := p.orderingSpecification(); err == nil {
+		j.Append(o)
+	}
+
+	if n, err := p.nullOrdering(); err == nil {
+		j.Append(n)
+	}
+
+	return j.Strip(), nil
+}
+
+func (p *Parser) sortKey() (Layout, error) {
+	return p.valueExpression()
+}
+
+func (p *Parser) orderingSpecification() (Layout, error) {
+	v, err := p.accept(keyword, "ASC", "DESC")
+	if err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+func (p *Parser) nullOrdering() (Layout, error) {
+	j := Juxtaposition{}
+
+	v, err := p.accept(keyword, "NULLS")
+	if err != nil {
+		return nil, err
+	}
+	j.Append(v)
+
+	v, err = p.accept(keyword, "FIRST", "LAST")
+	if err != nil {
+		return nil, err
+	}
+	j.Append(v)
+
+	return j, nil
+}
+
+func (p *Parser) resultOffsetClause() (Layout, error) {
+	j := Juxtaposition{}
+
+	v, err := p.accept(keyword, "OFFSET")
+	if err != nil {
+		return nil, err
+	}
+	j.Append(v)
+
+	c, err := p.offsetRowCount()
+	if err != nil {
+		return nil, fmt.Errorf("offset row count: %w", err)
+	}
+	j.Append(c)
+
+	v, err = p.accept(keyword, "ROW", "ROWS")
+	if err != nil {
+		return nil, err
+	}
+	j.Append(v)
+
+	return j, nil
+}
+
+func (p *Parser) offsetRowCount() (Layout, error) {
+	return p.simpleValueSpecification()
+}
+
+func (p *Parser) fetchFirstClause() (Layout, error) {
+	j := Juxtaposition{}
+
+	v, err := p.accept(keyword, "FETCH")
+	if err != nil {
+		return nil, err
+	}
+	j.Append(v)
+
+	v, err = p.accept(keyword, "FIRST", "NEXT")
+	if err != nil {
+		return nil, err
+	}
+	j.Append(v)
+
+	if q, err := p.fetchFirstQuantity(); err == nil {
+		j.Append(q)
+	}
+
+	v, err = p.accept(keyword, "ROW", "ROWS")
+	if err != nil {
+		return nil, err
+	}
+	j.Append(v)
+
+	if v, err := p.accept(keyword, "ONLY"); err == nil {
+		j.Append(v)
+		return j, nil
+	}
+
+	v, err = p.accept(keyword, "WITH")
+	if err != nil {
+		return nil, err
+	}
+	j.Append(v)
+
+	v, err = p.accept(keyword, "TIES")
+	if err != nil {
+		return nil, err
+	}
+	j.Append(v)
+
+	return j, nil
+}
+
+func (p *Parser) fetchFirstQuantity() (Layout, error) {
+	j := Juxtaposition{}
+
+	q, err := p.simpleValueSpecification()
+	if err != nil {
+		return nil, err
+	}
+	j.Append(q)
+
+	if v, err := p.accept(keyword, "PERCENT"); err == nil {
+		j.Append(v)
+	}
+
+	return j.Strip(), nil
+}
+
+func (p *Parser) simpleValueSpecification() (Layout, error) {
+	return p.unsignedLiteral()
+}
+
+func (p *Parser) correspondingSpec() (Layout, error) {
+	j := Juxtaposition{}
+
+	v, err := p.accept(keyword, "CORRESPONDING")
+	if err != nil {
+		return nil, err
+	}
+	j.Append(v)
+
+	if v, err := p.accept(keyword, "BY"); err == nil {
+		j.Append(v)
+
+		c := Concatenation{}
+
+		v, err = p.accept(leftParen)
+		if err != nil {
+			return nil, err
+		}
+		c.Append(v)
+
+		l, err := p.correspondingColumnList()
+		if err != nil {
+			return nil, err
+		}
+		c.Append(l)
+
+		v, err = p.accept(rightParen)
+		if err != nil {
+			return nil, err
+		}
+		c.Append(v)
+
+		j.Append(c)
+	}
+
+	return j, nil
+}
+
+func (p *Parser) correspondingColumnList() (Layout, error) {
+	return p.columnNameList()
+}
+
+func (p *Parser) querySpecification() (Layout, error) {
+	j := Juxtaposition{}
+	{
+		v, err := p.accept(keyword, "SELECT")
+		if err != nil {
+			return nil, err
+		}
+		j.Append(v)
+
+		if q, err := p.setQuantifier(); err == nil {
+			j.Append(q)
+		}
+
+		s, err := p.selectList()
+		if err != nil {
+			return nil, fmt.Errorf("select list: %w", err)
+		}
+		j.Append(s)
+	}
+
+	s := Stack{}
+	s.Append(j)
+
+	t, err := p.tableExpression()
+	if err != nil {
+		return nil, fmt.Errorf("table list: %w", err)
+	}
+	s.Append(t)
+
+	s.AlignGutter()
+
+	return s, nil
+}
+
+func (p *Parser) selectList() (Layout, error) {
+	if v, err := p.accept(asterisk); err == nil {
+		return v, nil
+	}
+
+	j := Juxtaposition{}
+
+	s, err := p.selectSublist()
+	if err != nil {
+		return nil, fmt.Errorf("select sublist: %w", err)
+	}
+
+	for {
+		v, err := p.accept(comma)
+		if err != nil {
+			break
+		}
+		j.Append(Concatenation{s, v})
+
+		s, err = p.selectSublist()
+		if err != nil {
+			return nil, fmt.Errorf("select sublist: %w", err)
+		}
+	}
+	j.Append(s)
+
+	return j, nil
+}
+
+func (p *Parser) selectSublist() (Layout, error) {
+	return p.derivedColumn()
+}
+
+func (p *Parser) derivedColumn() (Layout, error) {
+	j := Juxtaposition{}
+
+	v, err := p.valueExpression()
+	if err != nil {
+		return nil, fmt.Errorf("value expression: %w", err)
+	}
+	j.Append(v)
+
+	if a, err := p.asClause(); err == nil {
+		j.Append(a)
+	}
+
+	return j.Strip(), nil
+}
+
+func (p *Parser) asClause() (Layout, error) {
+	j := Juxtaposition{}
+
+	if v, err := p.accept(keyword, "AS"); err == nil {
+		j.Append(v)
+	}
+
+	c, err := p.columnName()
+	if err != nil {
+		return nil, fmt.Errorf("column name: %w", err)
+	}
+	j.Append(c)
+
+	return j, nil
+}
+
+func (p *Parser) valueExpression() (Layout, error) {
+	return p.commonValueExpression()
+}
+
+func (p *Parser) commonValueExpression() (Layout, error) {
+	// numeric value expression also covers the primaries of string and reference value expressions.
+	return p.numericValueExpression()
+}
+
+func (p *Parser) numericValueExpression() (Layout, error) {
+	j := Juxtaposition{}
+	s := Stack{}
+
+	t, err := p.term()
+	if err != nil {
+		return nil, fmt.Errorf("term: %w", err)
+	}
+	j.Append(t)
+	s.Append(t)
+
+	for {
+		o, err := p.sign()
+		if err != nil {
+			break
+		}
+
+		t, err := p.term()
+		if err != nil {
+			return nil, fmt.Errorf("term: %w", err)
+		}
+		j.Append(o, t)
+		s.Append(Juxtaposition{o, t})
+	}
+
+	if len(s) == 1 {
+		return t, nil
+	}
+
+	return Choice{One: j, Another: s}, nil
+}
+
+func (p *Parser) term() (Layout, error) {
+	j := Juxtaposition{}
+	s := Stack{}
+
+	f, err := p.factor()
+	if err != nil {
+		return nil, fmt.Errorf("factor: %w", err)
+	}
+	j.Append(f)
+	s.Append(f)
+
+	for {
+		o, err := p.accept(asterisk)
+		if err != nil {
+			o, err = p.accept(solidus)
+		}
+		if err != nil {
+			break
+		}
+
+		f, err := p.factor()
+		if err != nil {
+			return nil, fmt.Errorf("factor: %w", err)
+		}
+		j.Append(o, f)
+		s.Append(Juxtaposition{o, f})
+	}
+
+	if len(s) == 1 {
+		return f, nil
+	}
+
+	return Choice{One: j, Another: s}, nil
+}
+
+func (p *Parser) factor() (Layout, error) {
+	c := Concatenation{}
+
+	if v, err := p.sign(); err == nil {
+		c.Append(v)
+	}
+
+	n, err := p.numericPrimary()
+	if err != nil {
+		return nil, fmt.Errorf("numeric primary: %w", err)
+	}
+	c.Append(n)
+
+	return c.Strip(), nil
+}
+
+func (p *Parser) sign() (Layout, error) {
+	if v, err := p.accept(plusSign); err == nil {
+		return v, nil
+	}
+	return p.accept(minusSign)
+}
+
+func (p *Parser) numericPrimary() (Layout, error) {
+	if f, err := p.try(p.numericValueFunction); err == nil {
+		return f, nil
+	}
+	if f, err := p.try(p.stringValueFunction); err == nil {
+		return f, nil
+	}
+	return p.valueExpressionPrimary()
+}
+
+func (p *Parser) numericValueFunction() (Layout, error) {
+	if e, err := p.positionExpression(); err == nil {
 		return e, nil
 	}
-	return p.referenceValueExpression()
+	return p.extractExpression()
+}
+
+func (p *Parser) positionExpression() (Layout, error) {
+	c := Concatenation{}
+
+	v, err := p.accept(keyword, "POSITION")
+	if err != nil {
+		return nil, err
+	}
+	c.Append(v)
+
+	v, err = p.accept(leftParen)
+	if err != nil {
+		return nil, err
+	}
+	c.Append(v)
+
+	j := Juxtaposition{}
+
+	e, err := p.characterValueExpression()
+	if err != nil {
+		return nil, fmt.Errorf("character value expression: %w", err)
+	}
+	j.Append(e)
+
+	v, err = p.accept(keyword, "IN")
+	if err != nil {
+		return nil, err
+	}
+	j.Append(v)
+
+	e, err = p.characterValueExpression()
+	if err != nil {
+		return nil, fmt.Errorf("character value expression: %w", err)
+	}
+	j.Append(e)
+
+	c.Append(j)
+
+	v, err = p.accept(rightParen)
+	if err != nil {
+		return nil, err
+	}
+	c.Append(v)
+
+	return c, nil
+}
+
+func (p *Parser) extractExpression() (Layout, error) {
+	c := Concatenation{}
+
+	v, err := p.accept(keyword, "EXTRACT")
+	if err != nil {
+		return nil, err
+	}
+	c.Append(v)
+
+	v, err = p.accept(leftParen)
+	if err != nil {
+		return nil, err
+	}
+	c.Append(v)
+
+	j := Juxtaposition{}
+
+	f, err := p.extractField()
+	if err != nil {
+		return nil, fmt.Errorf("extract field: %w", err)
+	}
+	j.Append(f)
+
+	v, err = p.accept(keyword, "FROM")
+	if err != nil {
+		return nil, err
+	}
+	j.Append(v)
+
+	e, err := p.extractSource()
+	if err != nil {
+		return nil, fmt.Errorf("extract source: %w", err)
+	}
+	j.Append(e)
+
+	c.Append(j)
+
+	v, err = p.accept(rightParen)
+	if err != nil {
+		return nil, err
+	}
+	c.Append(v)
+
+	return c, nil
+}
+
+func (p *Parser) extractField() (Layout, error) {
+	if f, err := p.primaryDatetimeField(); err == nil {
+		return f, nil
+	}
+	return p.timeZoneField()
+}
+
+func (p *Parser) primaryDatetimeField() (Layout, error) {
+	if f, err := p.nonSecondPrimaryDatetimeField(); err == nil {
+		return f, nil
+	}
+	return p.accept(keyword, "SECOND")
+}
+
+func (p *Parser) nonSecondPrimaryDatetimeField() (Layout, error) {
+	v, err := p.accept(keyword, "YEAR", "MONTH", "DAY", "HOUR", "MINUTE")
+	if err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+func (p *Parser) timeZoneField() (Layout, error) {
+	v, err := p.accept(keyword, "TIMEZONE_HOUR", "TIMEZONE_MINUTE")
+	if err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+func (p *Parser) extractSource() (Layout, error) {
+	return p.valueExpression()
+}
+
+func (p *Parser) characterValueExpression() (Layout, error) {
+	return p.characterFactor()
+}
+
+func (p *Parser) characterFactor() (Layout, error) {
+	return p.characterPrimary()
+}
+
+func (p *Parser) characterPrimary() (Layout, error) {
+	if f, err := p.try(p.stringValueFunction); err == nil {
+		return f, nil
+	}
+	return p.valueExpressionPrimary()
+}
+
+func (p *Parser) stringValueFunction() (Layout, error) {
+	if f, err := p.characterSubstringFunction(); err == nil {
+		return f, nil
+	}
+	return p.trimFunction()
+}
+
+func (p *Parser) characterSubstringFunction() (Layout, error) {
+	c := Concatenation{}
+
+	v, err := p.accept(keyword, "SUBSTRING")
+	if err != nil {
+		return nil, err
+	}
+	c.Append(v)
+
+	v, err = p.accept(leftParen)
+	if err != nil {
+		return nil, err
+	}
+	c.Append(v)
+
+	j := Juxtaposition{}
+
+	e, err := p.characterValueExpression()
+	if err != nil {
+		return nil, fmt.Errorf("character value expression: %w", err)
+	}
+	j.Append(e)
+
+	v, err = p.accept(keyword, "FROM")
+	if err != nil {
+		return nil, err
+	}
+	j.Append(v)
+
+	e, err = p.numericValueExpression()
+	if err != nil {
+		return nil, fmt.Errorf("numeric value expression: %w", err)
+	}
+	j.Append(e)
+
+	if v, err := p.accept(keyword, "FOR"); err == nil {
+		j.Append(v)
+
+		e, err = p.numericValueExpression()
+		if err != nil {
+			return nil, fmt.Errorf("numeric value expression: %w", err)
+		}
+		j.Append(e)
+	}
+
+	c.Append(j)
+
+	v, err = p.accept(rightParen)
+	if err != nil {
+		return nil, err
+	}
+	c.Append(v)
+
+	return c, nil
+}
+
+func (p *Parser) trimFunction() (Layout, error) {
+	c := Concatenation{}
+
+	v, err := p.accept(keyword, "TRIM")
+	if err != nil {
+		return nil, err
+	}
+	c.Append(v)
+
+	v, err = p.accept(leftParen)
+	if err != nil {
+		return nil, err
+	}
+	c.Append(v)
+
+	o, err := p.trimOperands()
+	if err != nil {
+		return nil, fmt.Errorf("trim operands: %w", err)
+	}
+	c.Append(o)
+
+	v, err = p.accept(rightParen)
+	if err != nil {
+		return nil, err
+	}
+	c.Append(v)
+
+	return c, nil
+}
+
+func (p *Parser) trimOperands() (Layout, error) {
+	j := Juxtaposition{}
+
+	if v, err := p.accept(keyword, "LEADING", "TRAILING", "BOTH"); err == nil {
+		j.Append(v)
+
+		if e, err := p.characterValueExpression(); err == nil {
+			j.Append(e)
+		}
+
+		v, err := p.accept(keyword, "FROM")
+		if err != nil {
+			return nil, err
+		}
+		j.Append(v)
+	} else if e, err := p.characterValueExpression(); err == nil {
+		j.Append(e)
+
+		v, err := p.accept(keyword, "FROM")
+		if err != nil {
+			return j, nil
+		}
+		j.Append(v)
+	}
+
+	e, err := p.characterValueExpression()
+	if err != nil {
+		return nil, fmt.Errorf("character value expression: %w", err)
+	}
+	j.Append(e)
+
+	return j, nil
+}
+
+func (p *Parser) valueExpressionPrimary() (Layout, error) {
+	if v, err := p.try(p.parenthesizedValueExpressionPrimary); err == nil {
+		return v, nil
+	}
+	return p.nonparenthesizedValueExpressionPrimary()
+}
+
+func (p *Parser) parenthesizedValueExpressionPrimary() (Layout, error) {
+	c := Concatenation{}
+
+	v, err := p.accept(leftParen)
+	if err != nil {
+		return nil, err
+	}
+	c.Append(v)
+
+	e, err := p.valueExpression()
+	if err != nil {
+		return nil, fmt.Errorf("value expression: %w", err)
+	}
+	c.Append(e)
+
+	v, err = p.accept(rightParen)
+	if err != nil {
+		return nil, err
+	}
+	c.Append(v)
+
+	return c, nil
+}
+
+func (p *Parser) nonparenthesizedValueExpressionPrimary() (Layout, error) {
+	if v, err := p.unsignedValueSpecification(); err == nil {
+		return v, nil
+	}
+
+	if v, err := p.contextuallyTypedValueSpecification(); err == nil {
+		return v, nil
+	}
+
+	if s, err := p.try(p.scalarSubquery); err == nil {
+		return s, nil
+	}
+
+	if c, err := p.caseExpression(); err == nil {
+		return c, nil
+	}
+
+	if c, err := p.castSpecification(); err == nil {
+		return c, nil
+	}
+
+	// set functions and routine invocations are parsed as a part of window functions.
+	if f, err := p.try(p.windowFunction); err == nil {
+		return f, nil
+	}
+
+	return p.columnReference()
+}
+
+func (p *Parser) unsignedValueSpecification() (Layout, error) {
+	return p.unsignedLiteral()
+}
+
+func (p *Parser) unsignedLiteral() (Layout, error) {
+	if n, err := p.unsignedNumericLiteral(); err == nil {
+		return n, nil
+	}
+	return p.generalLiteral()
+}
+
+func (p *Parser) unsignedNumericLiteral() (Layout, error) {
+	n, err := p.accept(unsignedNumeric)
+	if err != nil {
+		return nil, err
+	}
+	return n, nil
+}
+
+func (p *Parser) generalLiteral() (Layout, error) {
+	if c, err := p.accept(characterString); err == nil {
+		return c, nil
+	}
+	return p.booleanLiteral()
+}
+
+func (p *Parser) booleanLiteral() (Layout, error) {
+	v, err := p.accept(keyword, "TRUE", "FALSE", "UNKNOWN")
+	if err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+func (p *Parser) contextuallyTypedValueSpecification() (Layout, error) {
+	return p.implicitlyTypedValueSpecification()
+}
+
+func (p *Parser) implicitlyTypedValueSpecification() (Layout, error) {
+	return p.nullSpecification()
+}
+
+func (p *Parser) nullSpecification() (Layout, error) {
+	v, err := p.accept(keyword, "NULL")
+	if err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+func (p *Parser) caseExpression() (Layout, error) {
+	if a, err := p.caseAbbreviation(); err == nil {
+		return a, nil
+	}
+	return p.caseSpecification()
+}
+
+func (p *Parser) caseAbbreviation() (Layout, error) {
+	if n, err := p.nullif(); err == nil {
+		return n, nil
+	}
+	return p.coalesce()
+}
+
+func (p *Parser) nullif() (Layout, error) {
+	c := Concatenation{}
+
+	v, err := p.accept(keyword, "NULLIF")
+	if err != nil {
+		return nil, err
+	}
+	c.Append(v)
+
+	v, err = p.accept(leftParen)
+	if err != nil {
+		return nil, err
+	}
+	c.Append(v)
+
+	j := Juxtaposition{}
+
+	e, err := p.valueExpression()
+	if err != nil {
+		return nil, fmt.Errorf("value expression: %w", err)
+	}
+
+	v, err = p.accept(comma)
+	if err != nil {
+		return nil, err
+	}
+	j.Append(Concatenation{e, v})
+
+	e, err = p.valueExpression()
+	if err != nil {
+		return nil, fmt.Errorf("value expression: %w", err)
+	}
+	j.Append(e)
+
+	c.Append(j)
+
+	v, err = p.accept(rightParen)
+	if err != nil {
+		return nil, err
+	}
+	c.Append(v)
+
+	return c, nil
+}
+
+func (p *Parser) coalesce() (Layout, error) {
+	c := Concatenation{}
+
+	v, err := p.accept(keyword, "COALESCE")
+	if err != nil {
+		return nil, err
+	}
+	c.Append(v)
+
+	v, err = p.accept(leftParen)
+	if err != nil {
+		return nil, err
+	}
+	c.Append(v)
+
+	j := Juxtaposition{}
+
+	e, err := p.valueExpression()
+	if err != nil {
+		return nil, fmt.Errorf("value expression: %w", err)
+	}
+
+	for {
+		v, err := p.accept(comma)
+		if err != nil {
+			break
+		}
+		j.Append(Concatenation{e, v})
+
+		e, err = p.valueExpression()
+		if err != nil {
+			return nil, fmt.Errorf("value expression: %w", err)
+		}
+	}
+	j.Append(e)
+
+	c.Append(j)
+
+	v, err = p.accept(rightParen)
+	if err != nil {
+		return nil, err
+	}
+	c.Append(v)
+
+	return c, nil
+}
+
+func (p *Parser) caseSpecification() (Layout, error) {
+	j := Juxtaposition{}
+
+	v, err := p.accept(keyword, "CASE")
+	if err != nil {
+		return nil, err
+	}
+	j.Append(v)
+
+	whenClause := p.searchedWhenClause
+	if o, err := p.caseOperand(); err == nil {
+		j.Append(o)
+		whenClause = p.simpleWhenClause
+	}
+
+	w := Juxtaposition{}
+	s := Stack{}
+
+	c, err := whenClause()
+	if err != nil {
+		return nil, fmt.Errorf("when clause: %w", err)
+	}
+	w.Append(c)
+	s.Append(c)
+
+	for {
+		c, err := whenClause()
+		if err != nil {
+			break
+		}
+		w.Append(c)
+		s.Append(c)
+	}
+
+	if e, err := p.elseClause(); err == nil {
+		w.Append(e)
+		s.Append(e)
+	}
+
+	e, err := p.accept(keyword, "END")
+	if err != nil {
+		return nil, err
+	}
+
+	o := Juxtaposition{}
+	o.Append(j, w, e)
+
+	a := Juxtaposition{}
+	a.Append(j, s)
+
+	return Choice{
+		One:     o,
+		Another: Stack{a, e},
+	}, nil
+}
+
+func (p *Parser) caseOperand() (Layout, error) {
+	return p.rowValuePredicand()
+}
+
+func (p *Parser) simpleWhenClause() (Layout, error) {
+	j := Juxtaposition{}
+
+	v, err := p.accept(keyword, "WHEN")
+	if err != nil {
+		return nil, err
+	}
+	j.Append(v)
+
+	l, err := p.whenOperandList()
+	if err != nil {
+		return nil, fmt.Errorf("when operand list: %w", err)
+	}
+	j.Append(l)
+
+	v, err = p.accept(keyword, "THEN")
+	if err != nil {
+		return nil, err
+	}
+	j.Append(v)
+
+	r, err := p.result()
+	if err != nil {
+		return nil, fmt.Errorf("result: %w", err)
+	}
+	j.Append(r)
+
+	return j, nil
+}
+
+func (p *Parser) searchedWhenClause() (Layout, error) {
+	j := Juxtaposition{}
+
+	v, err := p.accept(keyword, "WHEN")
+	if err != nil {
+		return nil, err
+	}
+	j.Append(v)
+
+	c, err := p.searchCondition()
+	if err != nil {
+		return nil, fmt.Errorf("search condition: %w", err)
+	}
+	j.Append(c)
+
+	v, err = p.accept(keyword, "THEN")
+	if err != nil {
+		return nil, err
+	}
+	j.Append(v)
+
+	r, err := p.result()
+	if err != nil {
+		return nil, fmt.Errorf("result: %w", err)
+	}
+	j.Append(r)
+
+	return j, nil
+}
+
+func (p *Parser) whenOperandList() (Layout, error) {
+	j := Juxtaposition{}
+
+	o, err := p.whenOperand()
+	if err != nil {
+		return nil, fmt.Errorf("when operand: %w", err)
+	}
+
+	for {
+		v, err := p.accept(comma)
+		if err != nil {
+			break
+		}
+		j.Append(Concatenation{o, v})
+
+		o, err = p.whenOperand()
+		if err != nil {
+			return nil, fmt.Errorf("when operand: %w", err)
+		}
+	}
+	j.Append(o)
+
+	return j, nil
+}
+
+func (p *Parser) whenOperand() (Layout, error) {
+	return p.rowValuePredicand()
+}
+
+func (p *Parser) elseClause() (Layout, error) {
+	j := Juxtaposition{}
+
+	v, err := p.accept(keyword, "ELSE")
+	if err != nil {
+		return nil, err
+	}
+	j.Append(v)
+
+	r, err := p.result()
+	if err != nil {
+		return nil, fmt.Errorf("result: %w", err)
+	}
+	j.Append(r)
+
+	return j, nil
+}
+
+func (p *Parser) result() (Layout, error) {
+	return p.resultExpression()
+}
+
+func (p *Parser) resultExpression() (Layout, error) {
+	return p.valueExpression()
+}
+
+func (p *Parser) columnReference() (Layout, error) {
+	return p.basicIdentifierChain()
+}
+
+func (p *Parser) basicIdentifierChain() (Layout, error) {
+	return p.identifierChain()
+}
+
+func (p *Parser) castSpecification() (Layout, error) {
+	c := Concatenation{}
+
+	v, err := p.accept(keyword, "CAST")
+	if err != nil {
+		return nil, err
+	}
+	c.Append(v)
+
+	v, err = p.accept(leftParen)
+	if err != nil {
+		return nil, err
+	}
+	c.Append(v)
+
+	j := Juxtaposition{}
+
+	o, err := p.castOperand()
+	if err != nil {
+		return nil, fmt.Errorf("cast operand: %w", err)
+	}
+	j.Append(o)
+
+	v, err = p.accept(keyword, "AS")
+	if err != nil {
+		return nil, err
+	}
+	j.Append(v)
+
+	t, err := p.castTarget()
+	if err != nil {
+		return nil, fmt.Errorf("cast target: %w", err)
+	}
+	j.Append(t)
+
+	c.Append(j)
+
+	v, err = p.accept(rightParen)
+	if err != nil {
+		return nil, err
+	}
+	c.Append(v)
+
+	return c, nil
+}
+
+func (p *Parser) castOperand() (Layout, error) {
+	return p.valueExpression()
+}
+
+func (p *Parser) castTarget() (Layout, error) {
+	return p.dataType()
+}
+
+func (p *Parser) routineInvocation() (Layout, error) {
+	c := Concatenation{}
+
+	n, err := p.routineName()
+	if err != nil {
+		return nil, fmt.Errorf("routine name: %w", err)
+	}
+	c.Append(n)
+
+	l, err := p.sqlArgumentList()
+	if err != nil {
+		return nil, fmt.Errorf("SQL argument list: %w", err)
+	}
+	c.Append(l)
+
+	return c, nil
+}
+
+// routineName also accepts keywords since many built-in functions are named after them.
+// Keywords of clauses and operators are left out so that e.g. WHEN ( isn't taken for a function call.
+func (p *Parser) routineName() (Layout, error) {
+	if _, err := p.expect(keyword,
+		"ALL", "AND", "ANY", "AS", "BETWEEN", "BY", "CASE", "DISTINCT", "ELSE", "END", "ESCAPE", "EXCEPT", "EXISTS",
+		"FILTER", "FROM", "HAVING", "IN", "INTERSECT", "IS", "JOIN", "LATERAL", "LIKE", "NOT", "ON", "OR", "OVER",
+		"SELECT", "SIMILAR", "SOME", "THEN", "UNION", "UNIQUE", "USING", "VALUES", "WHEN", "WHERE", "WITH", "WITHIN",
+	); err == nil {
+		return nil, &ErrUnexpected{
+			ExpectedType: identifier,
+			Actual:       p.current,
+		}
+	}
+
+	if v, err := p.accept(keyword); err == nil {
+		return v, nil
+	}
+	return p.identifierChain()
+}
+
+func (p *Parser) sqlArgumentList() (Layout, error) {
+	c := Concatenation{}
+
+	v, err := p.accept(leftParen)
+	if err != nil {
+		return nil, err
+	}
+	c.Append(v)
+
+	if v, err := p.accept(rightParen); err == nil {
+		c.Append(v)
+		return c, nil
+	}
+
+	j := Juxtaposition{}
+
+	a, err := p.sqlArgument()
+	if err != nil {
+		return nil, fmt.Errorf("SQL argument: %w", err)
+	}
+
+	for {
+		v, err := p.accept(comma)
+		if err != nil {
+			break
+		}
+		j.Append(Concatenation{a, v})
+
+		a, err = p.sqlArgument()
+		if err != nil {
+			return nil, fmt.Errorf("SQL argument: %w", err)
+		}
+	}
+	j.Append(a)
+
+	c.Append(j.Strip())
+
+	v, err = p.accept(rightParen)
+	if err != nil {
+		return nil, err
+	}
+	c.Append(v)
+
+	return c, nil
+}
+
+func (p *Parser) sqlArgument() (Layout, error) {
+	return p.valueExpression()
+}
+
+func (p *Parser) dataType() (Layout, error) {
+	if t, err := p.predefinedType(); err == nil {
+		return t, nil
+	}
+	return p.pathResolvedUserDefinedTypeName()
+}
+
+func (p *Parser) predefinedType() (Layout, error) {
+	if t, err := p.characterStringType(); err == nil {
+		return t, nil
+	}
+
+	if t, err := p.binaryStringType(); err == nil {
+		return t, nil
+	}
+
+	if t, err := p.numericType(); err == nil {
+		return t, nil
+	}
+
+	if t, err := p.booleanType(); err == nil {
+		return t, nil
+	}
+
+	if t, err := p.datetimeType(); err == nil {
+		return t, nil
+	}
+
+	return p.intervalType()
+}
+
+func (p *Parser) characterStringType() (Layout, error) {
+	j := Juxtaposition{}
+
+	if v, err := p.accept(keyword, "NATIONAL"); err == nil {
+		j.Append(v)
+	}
+
+	v, err := p.accept(keyword, "CHARACTER", "CHAR", "VARCHAR", "NCHAR", "CLOB", "NCLOB", "TEXT")
+	if err != nil {
+		return nil, err
+	}
+	j.Append(v)
+
+	if v, err := p.accept(keyword, "VARYING"); err == nil {
+		j.Append(v)
+	} else if l, err := p.largeObject(); err == nil {
+		j.Append(l)
+	}
+
+	c := Concatenation{}
+	c.Append(j.Strip())
+
+	if l, err := p.parenthesizedPrecision(); err == nil {
+		c.Append(l)
+	}
+
+	return c.Strip(), nil
+}
+
+func (p *Parser) binaryStringType() (Layout, error) {
+	j := Juxtaposition{}
+
+	v, err := p.accept(keyword, "BINARY", "VARBINARY", "BLOB")
+	if err != nil {
+		return nil, err
+	}
+	j.Append(v)
+
+	if v, err := p.accept(keyword, "VARYING"); err == nil {
+		j.Append(v)
+	} else if l, err := p.largeObject(); err == nil {
+		j.Append(l)
+	}
+
+	c := Concatenation{}
+	c.Append(j.Strip())
+
+	if l, err := p.parenthesizedPrecision(); err == nil {
+		c.Append(l)
+	}
+
+	return c.Strip(), nil
+}
+
+func (p *Parser) largeObject() (Layout, error) {
+	j := Juxtaposition{}
+
+	v, err := p.accept(keyword, "LARGE")
+	if err != nil {
+		return nil, err
+	}
+	j.Append(v)
+
+	v, err = p.accept(keyword, "OBJECT")
+	if err != nil {
+		return nil, err
+	}
+	j.Append(v)
+
+	return j, nil
+}
+
+func (p *Parser) numericType() (Layout, error) {
+	if t, err := p.exactNumericType(); err == nil {
+		return t, nil
+	}
+	return p.approximateNumericType()
+}
+
+func (p *Parser) exactNumericType() (Layout, error) {
+	if v, err := p.accept(keyword, "SMALLINT", "INTEGER", "INT", "BIGINT"); err == nil {
+		return v, nil
+	}
+
+	c := Concatenation{}
+
+	v, err := p.accept(keyword, "NUMERIC", "DECIMAL", "DEC")
+	if err != nil {
+		return nil, err
+	}
+	c.Append(v)
+
+	if l, err := p.parenthesizedPrecision(); err == nil {
+		c.Append(l)
+	}
+
+	return c.Strip(), nil
+}
+
+func (p *Parser) approximateNumericType() (Layout, error) {
+	if v, err := p.accept(keyword, "REAL"); err == nil {
+		return v, nil
+	}
+
+	if v, err := p.accept(keyword, "DOUBLE"); err == nil {
+		j := Juxtaposition{}
+		j.Append(v)
+
+		v, err := p.accept(keyword, "PRECISION")
+		if err != nil {
+			return nil, err
+		}
+		j.Append(v)
+
+		return j, nil
+	}
+
+	c := Concatenation{}
+
+	v, err := p.accept(keyword, "FLOAT")
+	if err != nil {
+		return nil, err
+	}
+	c.Append(v)
+
+	if l, err := p.parenthesizedPrecision(); err == nil {
+		c.Append(l)
+	}
+
+	return c.Strip(), nil
+}
+
+func (p *Parser) booleanType() (Layout, error) {
+	v, err := p.accept(keyword, "BOOLEAN")
+	if err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+func (p *Parser) datetimeType() (Layout, error) {
+	if v, err := p.accept(keyword, "DATE"); err == nil {
+		return v, nil
+	}
+
+	c := Concatenation{}
+
+	v, err := p.accept(keyword, "TIME", "TIMESTAMP")
+	if err != nil {
+		return nil, err
+	}
+	c.Append(v)
+
+	if l, err := p.parenthesizedPrecision(); err == nil {
+		c.Append(l)
+	}
+
+	j := Juxtaposition{}
+	j.Append(c.Strip())
+
+	if z, err := p.withOrWithoutTimeZone(); err == nil {
+		j.Append(z)
+	}
+
+	return j.Strip(), nil
+}
+
+func (p *Parser) withOrWithoutTimeZone() (Layout, error) {
+	j := Juxtaposition{}
+
+	v, err := p.accept(keyword, "WITH", "WITHOUT")
+	if err != nil {
+		return nil, err
+	}
+	j.Append(v)
+
+	v, err = p.accept(keyword, "TIME")
+	if err != nil {
+		return nil, err
+	}
+	j.Append(v)
+
+	v, err = p.accept(keyword, "ZONE")
+	if err != nil {
+		return nil, err
+	}
+	j.Append(v)
+
+	return j, nil
+}
+
+func (p *Parser) intervalType() (Layout, error) {
+	j := Juxtaposition{}
+
+	v, err := p.accept(keyword, "INTERVAL")
+	if err != nil {
+		return nil, err
+	}
+	j.Append(v)
+
+	q, err := p.intervalQualifier()
+	if err != nil {
+		return nil, fmt.Errorf("interval qualifier: %w", err)
+	}
+	j.Append(q)
+
+	return j, nil
+}
+
+func (p *Parser) intervalQualifier() (Layout, error) {
+	j := Juxtaposition{}
+
+	f, err := p.datetimeField()
+	if err != nil {
+		return nil, fmt.Errorf("datetime field: %w", err)
+	}
+	j.Append(f)
+
+	if v, err := p.accept(keyword, "TO"); err == nil {
+		j.Append(v)
+
+		f, err := p.datetimeField()
+		if err != nil {
+			return nil, fmt.Errorf("datetime field: %w", err)
+		}
+		j.Append(f)
+	}
+
+	return j.Strip(), nil
+}
+
+func (p *Parser) datetimeField() (Layout, error) {
+	c := Concatenation{}
+
+	f, err := p.primaryDatetimeField()
+	if err != nil {
+		return nil, err
+	}
+	c.Append(f)
+
+	if l, err := p.parenthesizedPrecision(); err == nil {
+		c.Append(l)
+	}
+
+	return c.Strip(), nil
+}
+
+// parenthesizedPrecision parses a parenthesized length, precision, or precision and scale.
+func (p *Parser) parenthesizedPrecision() (Layout, error) {
+	c := Concatenation{}
+
+	v, err := p.accept(leftParen)
+	if err != nil {
+		return nil, err
+	}
+	c.Append(v)
+
+	j := Juxtaposition{}
+
+	n, err := p.accept(unsignedNumeric)
+	if err != nil {
+		return nil, err
+	}
+
+	if v, err := p.accept(comma); err == nil {
+		j.Append(Concatenation{n, v})
+
+		n, err = p.accept(unsignedNumeric)
+		if err != nil {
+			return nil, err
+		}
+	}
+	j.Append(n)
+
+	c.Append(j.Strip())
+
+	v, err = p.accept(rightParen)
+	if err != nil {
+		return nil, err
+	}
+	c.Append(v)
+
+	return c, nil
+}
+
+func (p *Parser) pathResolvedUserDefinedTypeName() (Layout, error) {
+	return p.userDefinedTypeName()
+}
+
+func (p *Parser) userDefinedTypeName() (Layout, error) {
+	return p.identifierChain()
+}
+
+// windowFunction returns the window function type as is if OVER doesn't follow
+// so that a function call is parsed only once whether it's windowed or not.
+func (p *Parser) windowFunction() (Layout, error) {
+	j := Juxtaposition{}
+
+	t, err := p.windowFunctionType()
+	if err != nil {
+		return nil, fmt.Errorf("window function type: %w", err)
+	}
+	j.Append(t)
+
+	v, err := p.accept(keyword, "OVER")
+	if err != nil {
+		return t, nil
+	}
+	j.Append(v)
+
+	w, err := p.windowNameOrSpecification()
+	if err != nil {
+		return nil, fmt.Errorf("window name or specification: %w", err)
+	}
+	j.Append(w)
+
+	return j, nil
+}
+
+// windowFunctionType accepts any aggregate function or routine invocation since
+// ROW_NUMBER(), RANK(), NTILE(n), LEAD(x) and the like share the same syntax.
+func (p *Parser) windowFunctionType() (Layout, error) {
+	if f, err := p.try(p.setFunctionSpecification); err == nil {
+		return f, nil
+	}
+	return p.routineInvocation()
+}
+
+func (p *Parser) windowNameOrSpecification() (Layout, error) {
+	if n, err := p.windowName(); err == nil {
+		return n, nil
+	}
+	return p.windowSpecification()
+}
+
+func (p *Parser) windowName() (Layout, error) {
+	v, err := p.accept(identifier)
+	if err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+func (p *Parser) windowSpecification() (Layout, error) {
+	v, err := p.accept(leftParen)
+	if err != nil {
+		return nil, err
+	}
+
+	j := Juxtaposition{}
+	s := Stack{}
+
+	if n, err := p.existingWindowName(); err == nil {
+		j.Append(n)
+		s.Append(n)
+	}
+
+	if c, err := p.windowPartitionClause(); err == nil {
+		j.Append(c)
+		s.Append(c)
+	}
+
+	if c, err := p.windowOrderClause(); err == nil {
+		j.Append(c)
+		s.Append(c)
+	}
+
+	if c, err := p.windowFrameClause(); err == nil {
+		j.Append(c)
+		s.Append(c)
+	}
+
+	r, err := p.accept(rightParen)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(s) == 0 {
+		return Concatenation{v, r}, nil
+	}
+
+	s.AlignGutter()
+
+	return Choice{
+		One:     Concatenation{v, j, r},
+		Another: Concatenation{v, s, r},
+	}, nil
+}
+
+func (p *Parser) existingWindowName() (Layout, error) {
+	return p.windowName()
+}
+
+func (p *Parser) windowPartitionClause() (Layout, error) {
+	j := Juxtaposition{}
+
+	v, err := p.accept(keyword, "PARTITION")
+	if err != nil {
+		return nil, err
+	}
+	j.Append(v)
+
+	v, err = p.accept(keyword, "BY")
+	if err != nil {
+		return nil, err
+	}
+	j.Append(v)
+
+	l, err := p.windowPartitionColumnReferenceList()
+	if err != nil {
+		return nil, fmt.Errorf("window partition column reference list: %w", err)
+	}
+	j.Append(l)
+
+	return j, nil
+}
+
+func (p *Parser) windowPartitionColumnReferenceList() (Layout, error) {
+	j := Juxtaposition{}
+
+	r, err := p.windowPartitionColumnReference()
+	if err != nil {
+		return nil, fmt.Errorf("window partition column reference: %w", err)
+	}
+
+	for {
+		v, err := p.accept(comma)
+		if err != nil {
+			break
+		}
+		j.Append(Concatenation{r, v})
+
+		r, err = p.windowPartitionColumnReference()
+		if err != nil {
+			return nil, fmt.Errorf("window partition column reference: %w", err)
+		}
+	}
+	j.Append(r)
+
+	return j, nil
+}
+
+// windowPartitionColumnReference accepts any value expression as most dialects do.
+func (p *Parser) windowPartitionColumnReference() (Layout, error) {
+	return p.valueExpression()
+}
+
+func (p *Parser) windowOrderClause() (Layout, error) {
+	return p.orderByClause()
+}
+
+func (p *Parser) windowFrameClause() (Layout, error) {
+	j := Juxtaposition{}
+
+	u, err := p.windowFrameUnits()
+	if err != nil {
+		return nil, err
+	}
+	j.Append(u)
+
+	e, err := p.windowFrameExtent()
+	if err != nil {
+		return nil, fmt.Errorf("window frame extent: %w", err)
+	}
+	j.Append(e)
+
+	if x, err := p.windowFrameExclusion(); err == nil {
+		j.Append(x)
+	}
+
+	return j, nil
+}
+
+func (p *Parser) windowFrameUnits() (Layout, error) {
+	v, err := p.accept(keyword, "ROWS", "RANGE")
+	if err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+func (p *Parser) windowFrameExtent() (Layout, error) {
+	if b, err := p.windowFrameBetween(); err == nil {
+		return b, nil
+	}
+	return p.windowFrameStart()
+}
+
+func (p *Parser) windowFrameStart() (Layout, error) {
+	j := Juxtaposition{}
+
+	if v, err := p.accept(keyword, "CURRENT"); err == nil {
+		j.Append(v)
+
+		v, err := p.accept(keyword, "ROW")
+		if err != nil {
+			return nil, err
+		}
+		j.Append(v)
+
+		return j, nil
+	}
+
+	if v, err := p.accept(keyword, "UNBOUNDED"); err == nil {
+		j.Append(v)
+	} else {
+		n, err := p.unsignedValueSpecification()
+		if err != nil {
+			return nil, fmt.Errorf("unsigned value specification: %w", err)
+		}
+		j.Append(n)
+	}
+
+	v, err := p.accept(keyword, "PRECEDING")
+	if err != nil {
+		return nil, err
+	}
+	j.Append(v)
+
+	return j, nil
+}
+
+func (p *Parser) windowFrameBetween() (Layout, error) {
+	j := Juxtaposition{}
+
+	v, err := p.accept(keyword, "BETWEEN")
+	if err != nil {
+		return nil, err
+	}
+	j.Append(v)
+
+	b, err := p.windowFrameBound()
+	if err != nil {
+		return nil, fmt.Errorf("window frame bound: %w", err)
+	}
+	j.Append(b)
+
+	v, err = p.accept(keyword, "AND")
+	if err != nil {
+		return nil, err
+	}
+	j.Append(v)
+
+	b, err = p.windowFrameBound()
+	if err != nil {
+		return nil, fmt.Errorf("window frame bound: %w", err)
+	}
+	j.Append(b)
+
+	return j, nil
+}
+
+func (p *Parser) windowFrameBound() (Layout, error) {
+	if s, err := p.try(p.windowFrameStart); err == nil {
+		return s, nil
+	}
+	return p.windowFrameFollowing()
+}
+
+func (p *Parser) windowFrameFollowing() (Layout, error) {
+	j := Juxtaposition{}
+
+	if v, err := p.accept(keyword, "UNBOUNDED"); err == nil {
+		j.Append(v)
+	} else {
+		n, err := p.unsignedValueSpecification()
+		if err != nil {
+			return nil, fmt.Errorf("unsigned value specification: %w", err)
+		}
+		j.Append(n)
+	}
+
+	v, err := p.accept(keyword, "FOLLOWING")
+	if err != nil {
+		return nil, err
+	}
+	j.Append(v)
+
+	return j, nil
+}
+
+func (p *Parser) windowFrameExclusion() (Layout, error) {
+	j := Juxtaposition{}
+
+	v, err := p.accept(keyword, "EXCLUDE")
+	if err != nil {
+		return nil, err
+	}
+	j.Append(v)
+
+	if v, err := p.accept(keyword, "CURRENT"); err == nil {
+		j.Append(v)
+
+		v, err := p.accept(keyword, "ROW")
+		if err != nil {
+			return nil, err
+		}
+		j.Append(v)
+
+		return j, nil
+	}
+
+	if v, err := p.accept(keyword, "NO"); err == nil {
+		j.Append(v)
+
+		v, err := p.accept(keyword, "OTHERS")
+		if err != nil {
+			return nil, err
+		}
+		j.Append(v)
+
+		return j, nil
+	}
+
+	v, err = p.accept(keyword, "GROUP", "TIES")
+	if err != nil {
+		return nil, err
+	}
+	j.Append(v)
+
+	return j, nil
+}
+
+func (p *Parser) setFunctionSpecification() (Layout, error) {
+	return p.aggregateFunction()
+}
+
+func (p *Parser) aggregateFunction() (Layout, error) {
+	j := Juxtaposition{}
+
+	if f, err := p.try(p.countAsterisk); err == nil {
+		j.Append(f)
+	} else if f, err := p.try(p.generalSetFunction); err == nil {
+		j.Append(f)
+	} else {
+		f, err := p.orderedSetFunction()
+		if err != nil {
+			return nil, fmt.Errorf("ordered set function: %w", err)
+		}
+		j.Append(f)
+	}
+
+	if f, err := p.filterClause(); err == nil {
+		j.Append(f)
+	}
+
+	return j.Strip(), nil
+}
+
+func (p *Parser) countAsterisk() (Layout, error) {
+	c := Concatenation{}
+
+	v, err := p.accept(keyword, "COUNT")
+	if err != nil {
+		return nil, err
+	}
+	c.Append(v)
+
+	v, err = p.accept(leftParen)
+	if err != nil {
+		return nil, err
+	}
+	c.Append(v)
+
+	v, err = p.accept(asterisk)
+	if err != nil {
+		return nil, err
+	}
+	c.Append(v)
+
+	v, err = p.accept(rightParen)
+	if err != nil {
+		return nil, err
+	}
+	c.Append(v)
+
+	return c, nil
+}
+
+func (p *Parser) generalSetFunction() (Layout, error) {
+	c := Concatenation{}
+
+	f, err := p.setFunctionType()
+	if err != nil {
+		return nil, fmt.Errorf("set function type: %w", err)
+	}
+	c.Append(f)
+
+	v, err := p.accept(leftParen)
+	if err != nil {
+		return nil, err
+	}
+	c.Append(v)
+
+	j := Juxtaposition{}
+
+	if q, err := p.setQuantifier(); err == nil {
+		j.Append(q)
+	}
+
+	e, err := p.valueExpression()
+	if err != nil {
+		return nil, fmt.Errorf("value expression: %w", err)
+	}
+	j.Append(e)
+
+	c.Append(j.Strip())
+
+	v, err = p.accept(rightParen)
+	if err != nil {
+		return nil, err
+	}
+	c.Append(v)
+
+	return c, nil
+}
+
+func (p *Parser) setQuantifier() (Layout, error) {
+	v, err := p.accept(keyword, "DISTINCT", "ALL")
+	if err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+func (p *Parser) filterClause() (Layout, error) {
+	j := Juxtaposition{}
+
+	v, err := p.accept(keyword, "FILTER")
+	if err != nil {
+		return nil, err
+	}
+	j.Append(v)
+
+	c := Concatenation{}
+
+	v, err = p.accept(leftParen)
+	if err != nil {
+		return nil, err
+	}
+	c.Append(v)
+
+	w := Juxtaposition{}
+
+	v, err = p.accept(keyword, "WHERE")
+	if err != nil {
+		return nil, err
+	}
+	w.Append(v)
+
+	s, err := p.searchCondition()
+	if err != nil {
+		return nil, fmt.Errorf("search condition: %w", err)
+	}
+	w.Append(s)
+
+	c.Append(w)
+
+	v, err = p.accept(rightParen)
+	if err != nil {
+		return nil, err
+	}
+	c.Append(v)
+
+	j.Append(c)
+
+	return j, nil
+}
+
+func (p *Parser) orderedSetFunction() (Layout, error) {
+	if f, err := p.try(p.hypotheticalSetFunction); err == nil {
+		return f, nil
+	}
+	return p.inverseDistributionFunction()
+}
+
+func (p *Parser) hypotheticalSetFunction() (Layout, error) {
+	j := Juxtaposition{}
+	c := Concatenation{}
+
+	t, err := p.rankFunctionType()
+	if err != nil {
+		return nil, fmt.Errorf("rank function type: %w", err)
+	}
+	c.Append(t)
+
+	l, err := p.sqlArgumentList()
+	if err != nil {
+		return nil, fmt.Errorf("hypothetical set function value expression list: %w", err)
+	}
+	c.Append(l)
+
+	j.Append(c)
+
+	w, err := p.withinGroupSpecification()
+	if err != nil {
+		return nil, fmt.Errorf("within group specification: %w", err)
+	}
+	j.Append(w)
+
+	return j, nil
+}
+
+func (p *Parser) rankFunctionType() (Layout, error) {
+	v, err := p.accept(keyword, "RANK", "DENSE_RANK", "PERCENT_RANK", "CUME_DIST")
+	if err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+func (p *Parser) inverseDistributionFunction() (Layout, error) {
+	j := Juxtaposition{}
+	c := Concatenation{}
+
+	t, err := p.inverseDistributionFunctionType()
+	if err != nil {
+		return nil, fmt.Errorf("inverse distribution function type: %w", err)
+	}
+	c.Append(t)
+
+	v, err := p.accept(leftParen)
+	if err != nil {
+		return nil, err
+	}
+	c.Append(v)
+
+	e, err := p.valueExpression()
+	if err != nil {
+		return nil, fmt.Errorf("value expression: %w", err)
+	}
+	c.Append(e)
+
+	v, err = p.accept(rightParen)
+	if err != nil {
+		return nil, err
+	}
+	c.Append(v)
+
+	j.Append(c)
+
+	w, err := p.withinGroupSpecification()
+	if err != nil {
+		return nil, fmt.Errorf("within group specification: %w", err)
+	}
+	j.Append(w)
+
+	return j, nil
+}
+
+func (p *Parser) inverseDistributionFunctionType() (Layout, error) {
+	v, err := p.accept(keyword, "PERCENTILE_CONT", "PERCENTILE_DISC")
+	if err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+func (p *Parser) withinGroupSpecification() (Layout, error) {
+	j := Juxtaposition{}
+
+	v, err := p.accept(keyword, "WITHIN")
+	if err != nil {
+		return nil, err
+	}
+	j.Append(v)
+
+	v, err = p.accept(keyword, "GROUP")
+	if err != nil {
+		return nil, err
+	}
+	j.Append(v)
+
+	c := Concatenation{}
+
+	v, err = p.accept(leftParen)
+	if err != nil {
+		return nil, err
+	}
+	c.Append(v)
+
+	o, err := p.orderByClause()
+	if err != nil {
+		return nil, fmt.Errorf("order by clause: %w", err)
+	}
+	c.Append(o)
+
+	v, err = p.accept(rightParen)
+	if err != nil {
+		return nil, err
+	}
+	c.Append(v)
+
+	j.Append(c)
+
+	return j, nil
+}
+
+func (p *Parser) setFunctionType() (Layout, error) {
+	return p.computationalOperation()
+}
+
+func (p *Parser) computationalOperation() (Layout, error) {
+	v, err := p.accept(keyword, "AVG", "MAX", "MIN", "SUM", "EVERY", "ANY", "SOME", "COUNT", "STDDEV_POP", "STDDEV_SAMP", "VAR_SAMP", "VAR_POP", "COLLECT", "FUSION", "INTERSECTION")
+	if err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+func (p *Parser) identifierChain() (Layout, error) {
+	c := Concatenation{}
+
+	i, err := p.identifier()
+	if err != nil {
+		return nil, err
+	}
+	c.Append(c, i)
+
+	for {
+		v, err := p.accept(period)
+		if err != nil {
+			break
+		}
+		c.Append(v)
+
+		i, err := p.identifier()
+		if err != nil {
+			return nil, fmt.Errorf("identifier: %w", err)
+		}
+		c.Append(i)
+	}
+
+	return c.Strip(), nil
+}
+
+func (p *Parser) identifier() (Layout, error) {
+	return p.actualIdentifier()
+}
+
+func (p *Parser) actualIdentifier() (Layout, error) {
+	return p.regularIdentifier()
+}
+
+func (p *Parser) regularIdentifier() (Layout, error) {
+	v, err := p.accept(identifier)
+	if err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+func (p *Parser) tableExpression() (Layout, error) {
+	s := Stack{}
+
+	f, err := p.fromClause()
+	if err != nil {
+		return nil, err
+	}
+	s.Append(f)
+
+	if w, err := p.whereClause(); err == nil {
+		s.Append(w)
+	}
+
+	if g, err := p.groupByClause(); err == nil {
+		s.Append(g)
+	}
+
+	if h, err := p.havingClause(); err == nil {
+		s.Append(h)
+	}
+
+	if w, err := p.windowClause(); err == nil {
+		s.Append(w)
+	}
+
+	return s, nil
+}
+
+func (p *Parser) fromClause() (Layout, error) {
+	j := Juxtaposition{}
+
+	v, err := p.accept(keyword, "FROM")
+	if err != nil {
+		return nil, err
+	}
+	j.Append(v)
+
+	l, err := p.tableReferenceList()
+	if err != nil {
+		return nil, err
+	}
+	j.Append(l)
+
+	return j, nil
+}
+
+func (p *Parser) tableReferenceList() (Layout, error) {
+	j := Juxtaposition{}
+	s := Stack{}
+
+	// if a joined table spans multiple lines, every table reference goes on its own line.
+	var joined bool
+
+	t, err := p.tableReference()
+	if err != nil {
+		return nil, err
+	}
+
+	for {
+		if _, ok := t.(Stack); ok {
+			joined = true
+		}
+
+		v, err := p.accept(comma)
+		if err != nil {
+			break
+		}
+		j.Append(Concatenation{t, v})
+		s.Append(Concatenation{t, v})
+
+		t, err = p.tableReference()
+		if err != nil {
+			return nil, err
+		}
+	}
+	j.Append(t)
+	s.Append(t)
+
+	if joined {
+		return s, nil
+	}
+
+	return j, nil
+}
+
+func (p *Parser) tableReference() (Layout, error) {
+	s := Stack{}
+
+	f, err := p.tableFactor()
+	if err != nil {
+		return nil, err
+	}
+	s.Append(f)
+
+	for {
+		j, err := p.joinedTable()
+		if err != nil {
+			break
+		}
+		s.Append(j)
+	}
+
+	return s.Strip(), nil
+}
+
+// joinedTable parses a joined table after its leftmost table reference.
+func (p *Parser) joinedTable() (Layout, error) {
+	if j, err := p.crossJoin(); err == nil {
+		return j, nil
+	}
+
+	if j, err := p.naturalJoin(); err == nil {
+		return j, nil
+	}
+
+	return p.qualifiedJoin()
+}
+
+func (p *Parser) crossJoin() (Layout, error) {
+	j := Juxtaposition{}
+
+	v, err := p.accept(keyword, "CROSS")
+	if err != nil {
+		return nil, err
+	}
+	j.Append(v)
+
+	v, err = p.accept(keyword, "JOIN")
+	if err != nil {
+		return nil, err
+	}
+	j.Append(v)
+
+	f, err := p.tableFactor()
+	if err != nil {
+		return nil, fmt.Errorf("table factor: %w", err)
+	}
+	j.Append(f)
+
+	return j, nil
+}
+
+func (p *Parser) qualifiedJoin() (Layout, error) {
+	j := Juxtaposition{}
+
+	if t, err := p.joinType(); err == nil {
+		j.Append(t)
+	}
+
+	v, err := p.accept(keyword, "JOIN")
+	if err != nil {
+		return nil, err
+	}
+	j.Append(v)
+
+	f, err := p.tableFactor()
+	if err != nil {
+		return nil, fmt.Errorf("table factor: %w", err)
+	}
+	j.Append(f)
+
+	s, err := p.joinSpecification()
+	if err != nil {
+		return nil, fmt.Errorf("join specification: %w", err)
+	}
+
+	o := Juxtaposition{}
+	o.Append(j, s)
+
+	return Choice{
+		One:     o,
+		Another: Stack{j, s},
+	}, nil
+}
+
+func (p *Parser) naturalJoin() (Layout, error) {
+	j := Juxtaposition{}
+
+	v, err := p.accept(keyword, "NATURAL")
+	if err != nil {
+		return nil, err
+	}
+	j.Append(v)
+
+	if t, err := p.joinType(); err == nil {
+		j.Append(t)
+	}
+
+	v, err = p.accept(keyword, "JOIN")
+	if err != nil {
+		return nil, err
+	}
+	j.Append(v)
+
+	f, err := p.tableFactor()
+	if err != nil {
+		return nil, fmt.Errorf("table factor: %w", err)
+	}
+	j.Append(f)
+
+	return j, nil
+}
+
+func (p *Parser) joinSpecification() (Layout, error) {
+	if c, err := p.joinCondition(); err == nil {
+		return c, nil
+	}
+	return p.namedColumnsJoin()
+}
+
+func (p *Parser) joinCondition() (Layout, error) {
+	j := Juxtaposition{}
+
+	v, err := p.accept(keyword, "ON")
+	if err != nil {
+		return nil, err
+	}
+	j.Append(v)
+
+	c, err := p.searchCondition()
+	if err != nil {
+		return nil, fmt.Errorf("search condition: %w", err)
+	}
+	j.Append(c)
+
+	return j, nil
+}
+
+func (p *Parser) namedColumnsJoin() (Layout, error) {
+	j := Juxtaposition{}
+
+	v, err := p.accept(keyword, "USING")
+	if err != nil {
+		return nil, err
+	}
+	j.Append(v)
+
+	c := Concatenation{}
+
+	v, err = p.accept(leftParen)
+	if err != nil {
+		return nil, err
+	}
+	c.Append(v)
+
+	l, err := p.joinColumnList()
+	if err != nil {
+		return nil, fmt.Errorf("join column list: %w", err)
+	}
+	c.Append(l)
+
+	v, err = p.accept(rightParen)
+	if err != nil {
+		return nil, err
+	}
+	c.Append(v)
+
+	j.Append(c)
+
+	return j, nil
+}
+
+func (p *Parser) joinColumnList() (Layout, error) {
+	return p.columnNameList()
+}
+
+func (p *Parser) joinType() (Layout, error) {
+	if v, err := p.accept(keyword, "INNER"); err == nil {
+		return v, nil
+	}
+
+	j := Juxtaposition{}
+
+	t, err := p.outerJoinType()
+	if err != nil {
+		return nil, err
+	}
+	j.Append(t)
+
+	if v, err := p.accept(keyword, "OUTER"); err == nil {
+		j.Append(v)
+	}
+
+	return j, nil
+}
+
+func (p *Parser) outerJoinType() (Layout, error) {
+	v, err := p.accept(keyword, "LEFT", "RIGHT", "FULL")
+	if err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+func (p *Parser) tableFactor() (Layout, error) {
+	return p.tablePrimary()
+}
+
+func (p *Parser) tablePrimary() (Layout, error) {
+	if t, err := p.try(p.parenthesizedJoinedTable); err == nil {
+		return t, nil
+	}
+
+	j := Juxtaposition{}
+
+	if d, err := p.try(p.derivedTable); err == nil {
+		j.Append(d)
+	} else {
+		n, err := p.tableOrQueryName()
+		if err != nil {
+			return nil, err
+		}
+		j.Append(n)
+	}
+
+	if v, err := p.accept(keyword, "AS"); err == nil {
+		j.Append(v)
+
+		c, err := p.correlationName()
+		if err != nil {
+			return nil, err
+		}
+		j.Append(c)
+	} else if c, err := p.correlationName(); err == nil {
+		j.Append(c)
+	} else {
+		return j, nil
+	}
+
+	if l, err := p.parenthesizedDerivedColumnList(); err == nil {
+		j.Append(l)
+	}
+
+	return j, nil
+}
+
+func (p *Parser) derivedTable() (Layout, error) {
+	return p.tableSubquery()
+}
+
+func (p *Parser) parenthesizedDerivedColumnList() (Layout, error) {
+	c := Concatenation{}
+
+	v, err := p.accept(leftParen)
+	if err != nil {
+		return nil, err
+	}
+	c.Append(v)
+
+	l, err := p.derivedColumnList()
+	if err != nil {
+		return nil, fmt.Errorf("derived column list: %w", err)
+	}
+	c.Append(l)
+
+	v, err = p.accept(rightParen)
+	if err != nil {
+		return nil, err
+	}
+	c.Append(v)
+
+	return c, nil
+}
+
+func (p *Parser) derivedColumnList() (Layout, error) {
+	return p.columnNameList()
+}
+
+func (p *Parser) tableSubquery() (Layout, error) {
+	return p.subquery()
+}
+
+func (p *Parser) scalarSubquery() (Layout, error) {
+	return p.subquery()
+}
+
+func (p *Parser) subquery() (Layout, error) {
+	c := Concatenation{}
+
+	v, err := p.accept(leftParen)
+	if err != nil {
+		return nil, err
+	}
+	c.Append(v)
+
+	q, err := p.queryExpression()
+	if err != nil {
+		return nil, fmt.Errorf("query expression: %w", err)
+	}
+	c.Append(q)
+
+	v, err = p.accept(rightParen)
+	if err != nil {
+		return nil, err
+	}
+	c.Append(v)
+
+	return c, nil
+}
+
+func (p *Parser) parenthesizedJoinedTable() (Layout, error) {
+	c := Concatenation{}
+
+	v, err := p.accept(leftParen)
+	if err != nil {
+		return nil, err
+	}
+	c.Append(v)
+
+	t, err := p.tableReference()
+	if err != nil {
+		return nil, fmt.Errorf("table reference: %w", err)
+	}
+	c.Append(t)
+
+	v, err = p.accept(rightParen)
+	if err != nil {
+		return nil, err
+	}
+	c.Append(v)
+
+	return c, nil
+}
+
+func (p *Parser) tableOrQueryName() (Layout, error) {
+	return p.tableName()
+}
+
+func (p *Parser) columnNameList() (Layout, error) {
+	j := Juxtaposition{}
+
+	n, err := p.columnName()
+	if err != nil {
+		return nil, fmt.Errorf("column name: %w", err)
+	}
+
+	for {
+		v, err := p.accept(comma)
+		if err != nil {
+			break
+		}
+		j.Append(Concatenation{n, v})
+
+		n, err = p.columnName()
+		if err != nil {
+			return nil, err
+		}
+	}
+	j.Append(n)
+
+	return j, nil
+}
+
+func (p *Parser) whereClause() (Layout, error) {
+	v, err := p.accept(keyword, "WHERE")
+	if err != nil {
+		return nil, err
+	}
+
+	s, err := p.searchCondition()
+	if err != nil {
+		return nil, fmt.Errorf("search condition: %w", err)
+	}
+
+	if l, ok := s.(Stack); ok {
+		j := Juxtaposition{}
+		j.Append(v, l[0])
+		l[0] = j
+		return l, nil
+	} else {
+		j := Juxtaposition{}
+		j.Append(v, s)
+		return j, nil
+	}
+}
+
+func (p *Parser) groupByClause() (Layout, error) {
+	j := Juxtaposition{}
+
+	v, err := p.accept(keyword, "GROUP")
+	if err != nil {
+		return nil, err
+	}
+	j.Append(v)
+
+	v, err = p.accept(keyword, "BY")
+	if err != nil {
+		return nil, err
+	}
+	j.Append(v)
+
+	g, err := p.groupingElementList()
+	if err != nil {
+		return nil, fmt.Errorf("grouping element list: %w", err)
+	}
+	j.Append(g)
+
+	return j, nil
+}
+
+func (p *Parser) havingClause() (Layout, error) {
+	v, err := p.accept(keyword, "HAVING")
+	if err != nil {
+		return nil, err
+	}
+
+	s, err := p.searchCondition()
+	if err != nil {
+		return nil, fmt.Errorf("search condition: %w", err)
+	}
+
+	if l, ok := s.(Stack); ok {
+		j := Juxtaposition{}
+		j.Append(v, l[0])
+		l[0] = j
+		return l, nil
+	} else {
+		j := Juxtaposition{}
+		j.Append(v, s)
+		return j, nil
+	}
+}
+
+func (p *Parser) windowClause() (Layout, error) {
+	j := Juxtaposition{}
+
+	v, err := p.accept(keyword, "WINDOW")
+	if err != nil {
+		return nil, err
+	}
+	j.Append(v)
+
+	l, err := p.windowDefinitionList()
+	if err != nil {
+		return nil, fmt.Errorf("window definition list: %w", err)
+	}
+	j.Append(l)
+
+	return j, nil
+}
+
+func (p *Parser) windowDefinitionList() (Layout, error) {
+	j := Juxtaposition{}
+
+	d, err := p.windowDefinition()
+	if err != nil {
+		return nil, fmt.Errorf("window definition: %w", err)
+	}
+
+	for {
+		v, err := p.accept(comma)
+		if err != nil {
+			break
+		}
+		j.Append(Concatenation{d, v})
+
+		d, err = p.windowDefinition()
+		if err != nil {
+			return nil, fmt.Errorf("window definition: %w", err)
+		}
+	}
+	j.Append(d)
+
+	return j, nil
+}
+
+func (p *Parser) windowDefinition() (Layout, error) {
+	j := Juxtaposition{}
+
+	n, err := p.newWindowName()
+	if err != nil {
+		return nil, fmt.Errorf("new window name: %w", err)
+	}
+	j.Append(n)
+
+	v, err := p.accept(keyword, "AS")
+	if err != nil {
+		return nil, err
+	}
+	j.Append(v)
+
+	s, err := p.windowSpecification()
+	if err != nil {
+		return nil, fmt.Errorf("window specification: %w", err)
+	}
+	j.Append(s)
+
+	return j, nil
 }
 
-func (p *Parser) stringValueExpression() (Layout, error) {
-	return p.characterValueExpression()
+func (p *Parser) newWindowName() (Layout, error) {
+	return p.windowName()
 }
 
-func (p *Parser) characterValueExpression() (Layout, error) {
-	return p.characterFactor()
+func (p *Parser) groupingElementList() (Layout, error) {
+	j := Juxtaposition{}
+
+	e, err := p.groupingElement()
+	if err != nil {
+		return nil, fmt.Errorf("groupoing element: %w", err)
+	}
+
+	for {
+		v, err := p.accept(comma)
+		if err != nil {
+			break
+		}
+		j.Append(Concatenation{e, v})
+
+		e, err = p.groupingElement()
+		if err != nil {
+			return nil, fmt.Errorf("grouping element: %w", err)
+		}
+	}
+	j.Append(e)
+
+	return j, nil
 }
 
-func (p *Parser) characterFactor() (Layout, error) {
-	return p.characterPrimary()
+func (p *Parser) groupingElement() (Layout, error) {
+	return p.ordinaryGroupingSet()
 }
 
-func (p *Parser) characterPrimary() (Layout, error) {
-	return p.valueExpressionPrimary()
+func (p *Parser) ordinaryGroupingSet() (Layout, error) {
+	return p.groupingColumnReference()
 }
 
-func (p *Parser) valueExpressionPrimary() (Layout, error) {
-	return p.nonparenthesizedValueExpressionPrimary()
+func (p *Parser) groupingColumnReference() (Layout, error) {
+	return p.columnReference()
 }
 
-func (p *Parser) nonparenthesizedValueExpressionPrimary() (Layout, error) {
-	if v, err := p.unsignedValueSpecification(); err == nil {
-		return v, nil
+func (p *Parser) searchCondition() (Layout, error) {
+	return p.booleanValueExpression()
+}
+
+func (p *Parser) booleanValueExpression() (Layout, error) {
+	s := Stack{}
+
+	t, err := p.booleanTerm()
+	if err != nil {
+		return nil, fmt.Errorf("boolean term: %w", err)
 	}
+	s.Append(t)
 
-	if r, err := p.columnReference(); err == nil {
-		return r, nil
+	for {
+		j := Juxtaposition{}
+
+		v, err := p.accept(keyword, "OR")
+		if err != nil {
+			break
+		}
+		j.Append(v)
+
+		t, err = p.booleanTerm()
+		if err != nil {
+			return nil, fmt.Errorf("boolean term: %w", err)
+		}
+
+		if l, ok := t.(Stack); ok {
+			j.Append(l[0])
+			l[0] = j
+			s.Append(l)
+		} else {
+			j.Append(t)
+			s.Append(j)
+		}
 	}
 
-	return p.setFunctionSpecification()
+	return s.Strip(), nil
 }
 
-func (p *Parser) unsignedValueSpecification() (Layout, error) {
-	return p.unsignedLiteral()
-}
+func (p *Parser) booleanTerm() (Layout, error) {
+	s := Stack{}
 
-func (p *Parser) unsignedLiteral() (Layout, error) {
-	return p.generalLiteral()
+	f, err := p.booleanFactor()
+	if err != nil {
+		return nil, fmt.Errorf("boolean factor: %w", err)
+	}
+	s.Append(f)
+
+	for {
+		j := Juxtaposition{}
+
+		v, err := p.accept(keyword, "AND")
+		if err != nil {
+			break
+		}
+		j.Append(v)
+
+		f, err = p.booleanFactor()
+		if err != nil {
+			return nil, fmt.Errorf("boolean factor: %w", err)
+		}
+		j.Append(f)
+
+		s.Append(j)
+	}
+
+	return s.Strip(), nil
 }
 
-func (p *Parser) generalLiteral() (Layout, error) {
-	c, err := p.accept(characterString)
+func (p *Parser) booleanFactor() (Layout, error) {
+	j := Juxtaposition{}
+
+	if v, err := p.accept(keyword, "NOT"); err == nil {
+		j.Append(v)
+	}
+
+	t, err := p.booleanTest()
 	if err != nil {
 		return nil, err
 	}
-	return c, nil
+	j.Append(t)
+
+	return j, nil
 }
 
-func (p *Parser) columnReference() (Layout, error) {
-	return p.basicIdentifierChain()
+func (p *Parser) booleanTest() (Layout, error) {
+	j := Juxtaposition{}
+
+	b, err := p.booleanPrimary()
+	if err != nil {
+		return nil, err
+	}
+	j.Append(b)
+
+	v, err := p.accept(keyword, "IS")
+	if err != nil {
+		return j.Strip(), nil
+	}
+	j.Append(v)
+
+	if v, err := p.accept(keyword, "NOT"); err == nil {
+		j.Append(v)
+	}
+
+	t, err := p.truthValue()
+	if err != nil {
+		return nil, fmt.Errorf("truth value: %w", err)
+	}
+	j.Append(t)
+
+	return j, nil
 }
 
-func (p *Parser) basicIdentifierChain() (Layout, error) {
-	return p.identifierChain()
+func (p *Parser) truthValue() (Layout, error) {
+	v, err := p.accept(keyword, "TRUE", "FALSE", "UNKNOWN")
+	if err != nil {
+		return nil, err
+	}
+	return v, nil
 }
 
-func (p *Parser) setFunctionSpecification() (Layout, error) {
-	return p.aggregateFunction()
+func (p *Parser) booleanPrimary() (Layout, error) {
+	if v, err := p.try(p.predicate); err == nil {
+		return v, nil
+	}
+	return p.booleanPredicand()
 }
 
-func (p *Parser) aggregateFunction() (Layout, error) {
-	return p.generalSetFunction()
+func (p *Parser) booleanPredicand() (Layout, error) {
+	if e, err := p.try(p.parenthesizedBooleanValueExpression); err == nil {
+		return e, nil
+	}
+	return p.nonparenthesizedValueExpressionPrimary()
 }
 
-func (p *Parser) generalSetFunction() (Layout, error) {
+func (p *Parser) parenthesizedBooleanValueExpression() (Layout, error) {
 	c := Concatenation{}
 
-	f, err := p.setFunctionType()
-	if err != nil {
-		return nil, fmt.Errorf("set function type: %w", err)
-	}
-	c.Append(f)
-
 	v, err := p.accept(leftParen)
 	if err != nil {
 		return nil, err
 	}
 	c.Append(v)
 
-	e, err := p.valueExpression()
+	e, err := p.booleanValueExpression()
 	if err != nil {
-		return nil, fmt.Errorf("value expression: %w", err)
+		return nil, fmt.Errorf("boolean value expression: %w", err)
 	}
 	c.Append(e)
 
@@ -361,108 +7089,169 @@ func (p *Parser) generalSetFunction() (Layout, error) {
 	return c, nil
 }
 
-func (p *Parser) setFunctionType() (Layout, error) {
-	return p.computationalOperation()
-}
+func (p *Parser) predicate() (Layout, error) {
+	if e, err := p.existsPredicate(); err == nil {
+		return e, nil
+	}
 
-func (p *Parser) computationalOperation() (Layout, error) {
-	v, err := p.accept(keyword, "AVG", "MAX", "MIN", "SUM", "EVERY", "ANY", "SOME", "COUNT", "STDDEV_POP", "STDDEV_SAMP", "VAR_SAMP", "VAR_POP", "COLLECT", "FUSION", "INTERSECTION")
+	j := Juxtaposition{}
+
+	l, err := p.rowValuePredicand()
 	if err != nil {
-		return nil, err
+		return nil, fmt.Errorf("row value predicand: %w", err)
 	}
-	return v, nil
+	j.Append(l)
+
+	if r, err := p.try(p.quantifiedComparisonPredicatePart2); err == nil {
+		j.Append(r)
+		return j, nil
+	}
+
+	if r, err := p.try(p.inPredicatePart2); err == nil {
+		j.Append(r)
+		return j, nil
+	}
+
+	if r, err := p.try(p.betweenPredicatePart2); err == nil {
+		j.Append(r)
+		return j, nil
+	}
+
+	if r, err := p.try(p.characterLikePredicatePart2); err == nil {
+		j.Append(r)
+		return j, nil
+	}
+
+	if r, err := p.try(p.similarPredicatePart2); err == nil {
+		j.Append(r)
+		return j, nil
+	}
+
+	if r, err := p.try(p.nullPredicatePart2); err == nil {
+		j.Append(r)
+		return j, nil
+	}
+
+	if r, err := p.try(p.distinctPredicatePart3); err == nil {
+		j.Append(r)
+		return j, nil
+	}
+
+	r, err := p.comparisonPredicatePart2()
+	if err != nil {
+		return nil, fmt.Errorf("comparison predicate part 2: %w", err)
+	}
+	j.Append(r)
+
+	return j, nil
 }
 
-func (p *Parser) identifierChain() (Layout, error) {
-	c := Concatenation{}
+func (p *Parser) existsPredicate() (Layout, error) {
+	j := Juxtaposition{}
 
-	i, err := p.identifier()
+	v, err := p.accept(keyword, "EXISTS")
 	if err != nil {
 		return nil, err
 	}
-	c.Append(c, i)
-
-	for {
-		v, err := p.accept(period)
-		if err != nil {
-			break
-		}
-		c.Append(v)
+	j.Append(v)
 
-		i, err := p.identifier()
-		if err != nil {
-			return nil, fmt.Errorf("identifier: %w", err)
-		}
-		c.Append(i)
+	s, err := p.tableSubquery()
+	if err != nil {
+		return nil, fmt.Errorf("table subquery: %w", err)
 	}
+	j.Append(s)
 
-	return c.Strip(), nil
+	return j, nil
 }
 
-func (p *Parser) identifier() (Layout, error) {
-	return p.actualIdentifier()
-}
+func (p *Parser) quantifiedComparisonPredicatePart2() (Layout, error) {
+	j := Juxtaposition{}
+
+	o, err := p.compOp()
+	if err != nil {
+		return nil, fmt.Errorf("comp op: %w", err)
+	}
+	j.Append(o)
+
+	q, err := p.quantifier()
+	if err != nil {
+		return nil, fmt.Errorf("quantifier: %w", err)
+	}
+	j.Append(q)
+
+	s, err := p.tableSubquery()
+	if err != nil {
+		return nil, fmt.Errorf("table subquery: %w", err)
+	}
+	j.Append(s)
 
-func (p *Parser) actualIdentifier() (Layout, error) {
-	return p.regularIdentifier()
+	return j, nil
 }
 
-func (p *Parser) regularIdentifier() (Layout, error) {
-	v, err := p.accept(identifier)
+func (p *Parser) quantifier() (Layout, error) {
+	v, err := p.accept(keyword, "ALL", "SOME", "ANY")
 	if err != nil {
 		return nil, err
 	}
 	return v, nil
 }
 
-func (p *Parser) referenceValueExpression() (Layout, error) {
-	return p.valueExpressionPrimary()
-}
+func (p *Parser) inPredicatePart2() (Layout, error) {
+	j := Juxtaposition{}
 
-func (p *Parser) tableExpression() (Layout, error) {
-	s := Stack{}
+	if v, err := p.accept(keyword, "NOT"); err == nil {
+		j.Append(v)
+	}
 
-	f, err := p.fromClause()
+	v, err := p.accept(keyword, "IN")
 	if err != nil {
 		return nil, err
 	}
-	s.Append(f)
-
-	if w, err := p.whereClause(); err == nil {
-		s.Append(w)
-	}
+	j.Append(v)
 
-	if g, err := p.groupByClause(); err == nil {
-		s.Append(g)
+	i, err := p.inPredicateValue()
+	if err != nil {
+		return nil, fmt.Errorf("in predicate value: %w", err)
 	}
+	j.Append(i)
 
-	return s, nil
+	return j, nil
 }
 
-func (p *Parser) fromClause() (Layout, error) {
-	j := Juxtaposition{}
+func (p *Parser) inPredicateValue() (Layout, error) {
+	if s, err := p.try(p.tableSubquery); err == nil {
+		return s, nil
+	}
 
-	v, err := p.accept(keyword, "FROM")
+	c := Concatenation{}
+
+	v, err := p.accept(leftParen)
 	if err != nil {
 		return nil, err
 	}
-	j.Append(v)
+	c.Append(v)
 
-	l, err := p.tableReferenceList()
+	l, err := p.inValueList()
+	if err != nil {
+		return nil, fmt.Errorf("in value list: %w", err)
+	}
+	c.Append(l)
+
+	v, err = p.accept(rightParen)
 	if err != nil {
 		return nil, err
 	}
-	j.Append(l)
+	c.Append(v)
 
-	return j, nil
+	return c, nil
 }
 
-func (p *Parser) tableReferenceList() (Layout, error) {
+func (p *Parser) inValueList() (Layout, error) {
 	j := Juxtaposition{}
 
-	t, err := p.tableReference()
+	e, err := p.rowValueExpression()
 	if err != nil {
-		return nil, err
+		return nil, fmt.Errorf("row value expression: %w", err)
 	}
 
 	for {
@@ -470,245 +7259,198 @@ func (p *Parser) tableReferenceList() (Layout, error) {
 		if err != nil {
 			break
 		}
-		j.Append(Concatenation{t, v})
+		j.Append(Concatenation{e, v})
 
-		t, err = p.tableReference()
+		e, err = p.rowValueExpression()
 		if err != nil {
-			return nil, err
+			return nil, fmt.Errorf("row value expression: %w", err)
 		}
 	}
-	j.Append(t)
+	j.Append(e)
 
 	return j, nil
 }
 
-func (p *Parser) tableReference() (Layout, error) {
-	return p.tableFactor()
-}
-
-func (p *Parser) tableFactor() (Layout, error) {
-	return p.tablePrimary()
+func (p *Parser) rowValueExpression() (Layout, error) {
+	return p.valueExpression()
 }
 
-func (p *Parser) tablePrimary() (Layout, error) {
+func (p *Parser) betweenPredicatePart2() (Layout, error) {
 	j := Juxtaposition{}
 
-	n, err := p.tableOrQueryName()
+	if v, err := p.accept(keyword, "NOT"); err == nil {
+		j.Append(v)
+	}
+
+	v, err := p.accept(keyword, "BETWEEN")
 	if err != nil {
 		return nil, err
 	}
-	j.Append(n)
+	j.Append(v)
 
-	if v, err := p.accept(keyword, "AS"); err == nil {
+	if v, err := p.accept(keyword, "ASYMMETRIC", "SYMMETRIC"); err == nil {
 		j.Append(v)
+	}
 
-		c, err := p.correlationName()
-		if err != nil {
-			return nil, err
-		}
-		j.Append(c)
+	l, err := p.rowValuePredicand()
+	if err != nil {
+		return nil, fmt.Errorf("row value predicand: %w", err)
+	}
+	j.Append(l)
 
-		return j, nil
+	v, err = p.accept(keyword, "AND")
+	if err != nil {
+		return nil, err
 	}
+	j.Append(v)
 
-	if c, err := p.correlationName(); err == nil {
-		j.Append(c)
+	h, err := p.rowValuePredicand()
+	if err != nil {
+		return nil, fmt.Errorf("row value predicand: %w", err)
 	}
+	j.Append(h)
 
 	return j, nil
 }
 
-func (p *Parser) tableOrQueryName() (Layout, error) {
-	return p.tableName()
-}
-
-func (p *Parser) columnNameList() (Layout, error) {
+func (p *Parser) characterLikePredicatePart2() (Layout, error) {
 	j := Juxtaposition{}
 
-	n, err := p.columnName()
-	if err != nil {
-		return nil, fmt.Errorf("column name: %w", err)
-	}
-
-	for {
-		v, err := p.accept(comma)
-		if err != nil {
-			break
-		}
-		j.Append(Concatenation{n, v})
-
-		n, err = p.columnName()
-		if err != nil {
-			return nil, err
-		}
+	if v, err := p.accept(keyword, "NOT"); err == nil {
+		j.Append(v)
 	}
-	j.Append(n)
-
-	return j, nil
-}
 
-func (p *Parser) whereClause() (Layout, error) {
-	v, err := p.accept(keyword, "WHERE")
+	v, err := p.accept(keyword, "LIKE")
 	if err != nil {
 		return nil, err
 	}
+	j.Append(v)
 
-	s, err := p.searchCondition()
+	c, err := p.characterPattern()
 	if err != nil {
-		return nil, fmt.Errorf("search condition: %w", err)
+		return nil, fmt.Errorf("character pattern: %w", err)
 	}
+	j.Append(c)
 
-	if l, ok := s.(Stack); ok {
-		j := Juxtaposition{}
-		j.Append(v, l[0])
-		l[0] = j
-		return l, nil
-	} else {
-		j := Juxtaposition{}
-		j.Append(v, s)
-		return j, nil
+	if e, err := p.escapeClause(); err == nil {
+		j.Append(e)
 	}
+
+	return j, nil
 }
 
-func (p *Parser) groupByClause() (Layout, error) {
+func (p *Parser) characterPattern() (Layout, error) {
+	return p.characterValueExpression()
+}
+
+func (p *Parser) similarPredicatePart2() (Layout, error) {
 	j := Juxtaposition{}
 
-	v, err := p.accept(keyword, "GROUP")
+	if v, err := p.accept(keyword, "NOT"); err == nil {
+		j.Append(v)
+	}
+
+	v, err := p.accept(keyword, "SIMILAR")
 	if err != nil {
 		return nil, err
 	}
 	j.Append(v)
 
-	v, err = p.accept(keyword, "BY")
+	v, err = p.accept(keyword, "TO")
 	if err != nil {
 		return nil, err
 	}
 	j.Append(v)
 
-	g, err := p.groupingElementList()
+	c, err := p.similarPattern()
 	if err != nil {
-		return nil, fmt.Errorf("grouping element list: %w", err)
+		return nil, fmt.Errorf("similar pattern: %w", err)
+	}
+	j.Append(c)
+
+	if e, err := p.escapeClause(); err == nil {
+		j.Append(e)
 	}
-	j.Append(g)
 
 	return j, nil
 }
 
-func (p *Parser) groupingElementList() (Layout, error) {
+func (p *Parser) similarPattern() (Layout, error) {
+	return p.characterValueExpression()
+}
+
+func (p *Parser) escapeClause() (Layout, error) {
 	j := Juxtaposition{}
 
-	e, err := p.groupingElement()
+	v, err := p.accept(keyword, "ESCAPE")
 	if err != nil {
-		return nil, fmt.Errorf("groupoing element: %w", err)
+		return nil, err
 	}
+	j.Append(v)
 
-	for {
-		v, err := p.accept(comma)
-		if err != nil {
-			break
-		}
-		j.Append(Concatenation{e, v})
-
-		e, err = p.groupingElement()
-		if err != nil {
-			return nil, fmt.Errorf("grouping element: %w", err)
-		}
+	c, err := p.escapeCharacter()
+	if err != nil {
+		return nil, fmt.Errorf("escape character: %w", err)
 	}
-	j.Append(e)
+	j.Append(c)
 
 	return j, nil
 }
 
-func (p *Parser) groupingElement() (Layout, error) {
-	return p.ordinaryGroupingSet()
-}
-
-func (p *Parser) ordinaryGroupingSet() (Layout, error) {
-	return p.groupingColumnReference()
-}
-
-func (p *Parser) groupingColumnReference() (Layout, error) {
-	return p.columnReference()
-}
-
-func (p *Parser) searchCondition() (Layout, error) {
-	return p.booleanValueExpression()
+func (p *Parser) escapeCharacter() (Layout, error) {
+	return p.characterValueExpression()
 }
 
-func (p *Parser) booleanValueExpression() (Layout, error) {
-	s := Stack{}
+func (p *Parser) nullPredicatePart2() (Layout, error) {
+	j := Juxtaposition{}
 
-	t, err := p.booleanTerm()
+	v, err := p.accept(keyword, "IS")
 	if err != nil {
-		return nil, fmt.Errorf("boolean term: %w", err)
+		return nil, err
 	}
-	s.Append(t)
-
-	for {
-		j := Juxtaposition{}
+	j.Append(v)
 
-		v, err := p.accept(keyword, "OR")
-		if err != nil {
-			break
-		}
+	if v, err := p.accept(keyword, "NOT"); err == nil {
 		j.Append(v)
-
-		t, err = p.booleanTerm()
-		if err != nil {
-			return nil, fmt.Errorf("boolean term: %w", err)
-		}
-		j.Append(t)
-
-		s.Append(j)
 	}
 
-	return s.Strip(), nil
-}
+	v, err = p.accept(keyword, "NULL")
+	if err != nil {
+		return nil, err
+	}
+	j.Append(v)
 
-func (p *Parser) booleanTerm() (Layout, error) {
-	return p.booleanFactor()
+	return j, nil
 }
 
-func (p *Parser) booleanFactor() (Layout, error) {
+func (p *Parser) distinctPredicatePart3() (Layout, error) {
 	j := Juxtaposition{}
 
+	v, err := p.accept(keyword, "IS")
+	if err != nil {
+		return nil, err
+	}
+	j.Append(v)
+
 	if v, err := p.accept(keyword, "NOT"); err == nil {
 		j.Append(v)
 	}
 
-	t, err := p.booleanTest()
+	v, err = p.accept(keyword, "DISTINCT")
 	if err != nil {
 		return nil, err
 	}
-	j.Append(t)
-
-	return j, nil
-}
-
-func (p *Parser) booleanTest() (Layout, error) {
-	return p.booleanPrimary()
-}
-
-func (p *Parser) booleanPrimary() (Layout, error) {
-	return p.predicate()
-}
-
-func (p *Parser) predicate() (Layout, error) {
-	return p.comparisonPredicate()
-}
-
-func (p *Parser) comparisonPredicate() (Layout, error) {
-	j := Juxtaposition{}
+	j.Append(v)
 
-	l, err := p.rowValuePredicand()
+	v, err = p.accept(keyword, "FROM")
 	if err != nil {
-		return nil, fmt.Errorf("row value predicand: %w", err)
+		return nil, err
 	}
-	j.Append(l)
+	j.Append(v)
 
-	r, err := p.comparisonPredicatePart2()
+	r, err := p.rowValuePredicand()
 	if err != nil {
-		return nil, fmt.Errorf("comparison predicate part 2: %w", err)
+		return nil, fmt.Errorf("row value predicand: %w", err)
 	}
 	j.Append(r)
 
@@ -800,16 +7542,9 @@ func (p *Parser) greaterThanOrEqualsOperator() (Layout, error) {
 }
 
 func (p *Parser) rowValuePredicand() (Layout, error) {
-	if v, err := p.rowValueSpecialCase(); err == nil {
-		return v, nil
-	}
 	return p.rowValueConstructorPredicand()
 }
 
-func (p *Parser) rowValueSpecialCase() (Layout, error) {
-	return p.nonparenthesizedValueExpressionPrimary()
-}
-
 func (p *Parser) rowValueConstructorPredicand() (Layout, error) {
 	return p.commonValueExpression()
 }
@@ -853,10 +7588,25 @@ func (p *Parser) accept(t tokenType, vals ...string) (Layout, error) {
 	if err != nil {
 		return nil, err
 	}
-	p.current = p.lexer.Next()
+	p.pos++
+	if p.pos == len(p.tokens) {
+		p.tokens = append(p.tokens, p.lexer.Next())
+	}
+	p.current = p.tokens[p.pos]
 	return Atom(v), nil
 }
 
+// try runs f and, if it fails, rewinds the parser to where f started so that another alternative can be tried.
+func (p *Parser) try(f func() (Layout, error)) (Layout, error) {
+	pos := p.pos
+	l, err := f()
+	if err != nil {
+		p.pos = pos
+		p.current = p.tokens[pos]
+	}
+	return l, err
+}
+
 func (p *Parser) expect(t tokenType, vals ...string) (string, error) {
 	if p.current.typ != t {
 		return "", &ErrUnexpected{
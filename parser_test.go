@@ -165,4 +165,1422 @@ UNION ALL
 			Atom(";"),
 		}, l)
 	})
+
+	t.Run("and", func(t *testing.T) {
+		p := NewParser(`SELECT x FROM t WHERE x = 'a' AND y = 'b' OR NOT (z = 'c' OR w = 'd') AND v = 'e';`)
+		l, err := p.DirectSQLStatement()
+		assert.NoError(t, err)
+		assert.Equal(t, Concatenation{
+			Stack{
+				Juxtaposition{Atom("SELECT"), Atom("x")},
+				Juxtaposition{Atom("  FROM"), Atom("t")},
+				Juxtaposition{Atom(" WHERE"), Atom("x"), Atom("="), Atom("'a'")},
+				Juxtaposition{Atom("   AND"), Atom("y"), Atom("="), Atom("'b'")},
+				Juxtaposition{
+					Atom("    OR"),
+					Atom("NOT"),
+					Concatenation{
+						Atom("("),
+						Stack{
+							Juxtaposition{Atom("z"), Atom("="), Atom("'c'")},
+							Juxtaposition{Atom("OR"), Atom("w"), Atom("="), Atom("'d'")},
+						},
+						Atom(")"),
+					},
+				},
+				Juxtaposition{Atom("   AND"), Atom("v"), Atom("="), Atom("'e'")},
+			},
+			Atom(";"),
+		}, l)
+	})
+
+	t.Run("arithmetic", func(t *testing.T) {
+		p := NewParser(`SELECT -x * (y + z) / w - v FROM t;`)
+		l, err := p.DirectSQLStatement()
+		assert.NoError(t, err)
+
+		sum := Choice{
+			One:     Juxtaposition{Atom("y"), Atom("+"), Atom("z")},
+			Another: Stack{Atom("y"), Juxtaposition{Atom("+"), Atom("z")}},
+		}
+		product := Choice{
+			One: Juxtaposition{
+				Concatenation{Atom("-"), Atom("x")},
+				Atom("*"),
+				Concatenation{Atom("("), sum, Atom(")")},
+				Atom("/"),
+				Atom("w"),
+			},
+			Another: Stack{
+				Concatenation{Atom("-"), Atom("x")},
+				Juxtaposition{Atom("*"), Concatenation{Atom("("), sum, Atom(")")}},
+				Juxtaposition{Atom("/"), Atom("w")},
+			},
+		}
+		assert.Equal(t, Concatenation{
+			Stack{
+				Juxtaposition{
+					Atom("SELECT"),
+					Choice{
+						One:     Juxtaposition{product, Atom("-"), Atom("v")},
+						Another: Stack{product, Juxtaposition{Atom("-"), Atom("v")}},
+					},
+				},
+				Juxtaposition{Atom("  FROM"), Atom("t")},
+			},
+			Atom(";"),
+		}, l)
+	})
+
+	t.Run("literals", func(t *testing.T) {
+		p := NewParser(`select qty, 1.5e-3 from t where qty > 10 and flag = true or note = null;`)
+		l, err := p.DirectSQLStatement()
+		assert.NoError(t, err)
+		assert.Equal(t, Concatenation{
+			Stack{
+				Juxtaposition{Atom("SELECT"), Concatenation{Atom("qty"), Atom(",")}, Atom("1.5e-3")},
+				Juxtaposition{Atom("  FROM"), Atom("t")},
+				Juxtaposition{Atom(" WHERE"), Atom("qty"), Atom(">"), Atom("10")},
+				Juxtaposition{Atom("   AND"), Atom("flag"), Atom("="), Atom("TRUE")},
+				Juxtaposition{Atom("    OR"), Atom("note"), Atom("="), Atom("NULL")},
+			},
+			Atom(";"),
+		}, l)
+	})
+
+	t.Run("order by", func(t *testing.T) {
+		p := NewParser(`SELECT x FROM t ORDER BY x DESC NULLS LAST, y OFFSET 10 ROWS FETCH FIRST 5 ROWS ONLY;`)
+		l, err := p.DirectSQLStatement()
+		assert.NoError(t, err)
+		assert.Equal(t, Concatenation{
+			Stack{
+				Juxtaposition{Atom("SELECT"), Atom("x")},
+				Juxtaposition{Atom("  FROM"), Atom("t")},
+				Juxtaposition{
+					Atom(" ORDER"),
+					Atom("BY"),
+					Concatenation{
+						Juxtaposition{Atom("x"), Atom("DESC"), Atom("NULLS"), Atom("LAST")},
+						Atom(","),
+					},
+					Atom("y"),
+				},
+				Juxtaposition{Atom("OFFSET"), Atom("10"), Atom("ROWS")},
+				Juxtaposition{Atom(" FETCH"), Atom("FIRST"), Atom("5"), Atom("ROWS"), Atom("ONLY")},
+			},
+			Atom(";"),
+		}, l)
+	})
+
+	t.Run("order by in parentheses", func(t *testing.T) {
+		p := NewParser(`(SELECT x FROM t ORDER BY x FETCH NEXT 1 ROW WITH TIES) UNION (SELECT y FROM u);`)
+		l, err := p.DirectSQLStatement()
+		assert.NoError(t, err)
+		assert.Equal(t, Concatenation{
+			Stack{
+				Concatenation{
+					Atom("("),
+					Stack{
+						Juxtaposition{Atom("SELECT"), Atom("x")},
+						Juxtaposition{Atom("  FROM"), Atom("t")},
+						Juxtaposition{Atom(" ORDER"), Atom("BY"), Atom("x")},
+						Juxtaposition{Atom(" FETCH"), Atom("NEXT"), Atom("1"), Atom("ROW"), Atom("WITH"), Atom("TIES")},
+					},
+					Atom(")"),
+				},
+				Juxtaposition{Atom("  UNION")},
+				Concatenation{
+					Atom("("),
+					Stack{
+						Juxtaposition{Atom("SELECT"), Atom("y")},
+						Juxtaposition{Atom("  FROM"), Atom("u")},
+					},
+					Atom(")"),
+				},
+			},
+			Atom(";"),
+		}, l)
+
+		p = NewParser(`(WITH cc AS (SELECT x FROM t) SELECT x FROM cc) UNION (SELECT y FROM u);`)
+		l, err = p.DirectSQLStatement()
+		assert.NoError(t, err)
+		assert.Equal(t, Concatenation{
+			Stack{
+				Concatenation{
+					Atom("("),
+					Stack{
+						Juxtaposition{Atom("  WITH"), Stack{
+							Juxtaposition{Atom("cc"), Atom("AS"), Atom("(")},
+							Indent{Stack{
+								Juxtaposition{Atom("SELECT"), Atom("x")},
+								Juxtaposition{Atom("  FROM"), Atom("t")},
+							}},
+							Atom(")"),
+						}},
+						Juxtaposition{Atom("SELECT"), Atom("x")},
+						Juxtaposition{Atom("  FROM"), Atom("cc")},
+					},
+					Atom(")"),
+				},
+				Juxtaposition{Atom("  UNION")},
+				Concatenation{
+					Atom("("),
+					Stack{
+						Juxtaposition{Atom("SELECT"), Atom("y")},
+						Juxtaposition{Atom("  FROM"), Atom("u")},
+					},
+					Atom(")"),
+				},
+			},
+			Atom(";"),
+		}, l)
+	})
+
+	t.Run("having", func(t *testing.T) {
+		p := NewParser(`SELECT x, MAX(y) FROM t GROUP BY x HAVING MAX(y) > 10 AND MIN(y) < 5 OR AVG(y) = 7;`)
+		l, err := p.DirectSQLStatement()
+		assert.NoError(t, err)
+		assert.Equal(t, Concatenation{
+			Stack{
+				Juxtaposition{
+					Atom("SELECT"),
+					Concatenation{Atom("x"), Atom(",")},
+					Concatenation{Atom("MAX"), Atom("("), Atom("y"), Atom(")")},
+				},
+				Juxtaposition{Atom("  FROM"), Atom("t")},
+				Juxtaposition{Atom(" GROUP"), Atom("BY"), Atom("x")},
+				Juxtaposition{
+					Atom("HAVING"),
+					Concatenation{Atom("MAX"), Atom("("), Atom("y"), Atom(")")},
+					Atom(">"),
+					Atom("10"),
+				},
+				Juxtaposition{
+					Atom("   AND"),
+					Concatenation{Atom("MIN"), Atom("("), Atom("y"), Atom(")")},
+					Atom("<"),
+					Atom("5"),
+				},
+				Juxtaposition{
+					Atom("    OR"),
+					Concatenation{Atom("AVG"), Atom("("), Atom("y"), Atom(")")},
+					Atom("="),
+					Atom("7"),
+				},
+			},
+			Atom(";"),
+		}, l)
+	})
+
+	t.Run("join", func(t *testing.T) {
+		p := NewParser(`SELECT x FROM t INNER JOIN u ON t.id = u.id LEFT OUTER JOIN v USING (id) CROSS JOIN w;`)
+		l, err := p.DirectSQLStatement()
+		assert.NoError(t, err)
+
+		on := Juxtaposition{
+			Atom("ON"),
+			Concatenation{Atom("t"), Atom("."), Atom("id")},
+			Atom("="),
+			Concatenation{Atom("u"), Atom("."), Atom("id")},
+		}
+		using := Juxtaposition{
+			Atom("USING"),
+			Concatenation{Atom("("), Juxtaposition{Atom("id")}, Atom(")")},
+		}
+		assert.Equal(t, Concatenation{
+			Stack{
+				Juxtaposition{Atom("SELECT"), Atom("x")},
+				Juxtaposition{
+					Atom("  FROM"),
+					Stack{
+						Juxtaposition{Atom("t")},
+						Choice{
+							One:     append(Juxtaposition{Atom("INNER"), Atom("JOIN"), Atom("u")}, on...),
+							Another: Stack{Juxtaposition{Atom("INNER"), Atom("JOIN"), Atom("u")}, on},
+						},
+						Choice{
+							One:     append(Juxtaposition{Atom("LEFT"), Atom("OUTER"), Atom("JOIN"), Atom("v")}, using...),
+							Another: Stack{Juxtaposition{Atom("LEFT"), Atom("OUTER"), Atom("JOIN"), Atom("v")}, using},
+						},
+						Juxtaposition{Atom("CROSS"), Atom("JOIN"), Atom("w")},
+					},
+				},
+			},
+			Atom(";"),
+		}, l)
+	})
+
+	t.Run("subquery", func(t *testing.T) {
+		p := NewParser(`SELECT (SELECT MAX(y) FROM u) AS mx FROM (SELECT x FROM v) AS t WHERE EXISTS (SELECT y FROM w) AND x NOT IN (SELECT z FROM q) OR x = ANY (SELECT z FROM r);`)
+		l, err := p.DirectSQLStatement()
+		assert.NoError(t, err)
+		assert.Equal(t, Concatenation{
+			Stack{
+				Juxtaposition{
+					Atom("SELECT"),
+					Concatenation{
+						Atom("("),
+						Stack{
+							Juxtaposition{Atom("SELECT"), Concatenation{Atom("MAX"), Atom("("), Atom("y"), Atom(")")}},
+							Juxtaposition{Atom("  FROM"), Atom("u")},
+						},
+						Atom(")"),
+					},
+					Atom("AS"),
+					Atom("mx"),
+				},
+				Juxtaposition{
+					Atom("  FROM"),
+					Concatenation{
+						Atom("("),
+						Stack{
+							Juxtaposition{Atom("SELECT"), Atom("x")},
+							Juxtaposition{Atom("  FROM"), Atom("v")},
+						},
+						Atom(")"),
+					},
+					Atom("AS"),
+					Atom("t"),
+				},
+				Juxtaposition{
+					Atom(" WHERE"),
+					Atom("EXISTS"),
+					Concatenation{
+						Atom("("),
+						Stack{
+							Juxtaposition{Atom("SELECT"), Atom("y")},
+							Juxtaposition{Atom("  FROM"), Atom("w")},
+						},
+						Atom(")"),
+					},
+				},
+				Juxtaposition{
+					Atom("   AND"),
+					Atom("x"),
+					Atom("NOT"),
+					Atom("IN"),
+					Concatenation{
+						Atom("("),
+						Stack{
+							Juxtaposition{Atom("SELECT"), Atom("z")},
+							Juxtaposition{Atom("  FROM"), Atom("q")},
+						},
+						Atom(")"),
+					},
+				},
+				Juxtaposition{
+					Atom("    OR"),
+					Atom("x"),
+					Atom("="),
+					Atom("ANY"),
+					Concatenation{
+						Atom("("),
+						Stack{
+							Juxtaposition{Atom("SELECT"), Atom("z")},
+							Juxtaposition{Atom("  FROM"), Atom("r")},
+						},
+						Atom(")"),
+					},
+				},
+			},
+			Atom(";"),
+		}, l)
+	})
+
+	t.Run("predicates", func(t *testing.T) {
+		p := NewParser(`SELECT x FROM t WHERE x IN (1, 2) AND y NOT BETWEEN 1 AND 10 AND z LIKE 'a%' ESCAPE '!' OR w SIMILAR TO 'b%' AND v IS NOT NULL AND u IS DISTINCT FROM x AND flag IS NOT TRUE;`)
+		l, err := p.DirectSQLStatement()
+		assert.NoError(t, err)
+		assert.Equal(t, Concatenation{
+			Stack{
+				Juxtaposition{Atom("SELECT"), Atom("x")},
+				Juxtaposition{Atom("  FROM"), Atom("t")},
+				Juxtaposition{
+					Atom(" WHERE"),
+					Atom("x"),
+					Atom("IN"),
+					Concatenation{
+						Atom("("),
+						Juxtaposition{Concatenation{Atom("1"), Atom(",")}, Atom("2")},
+						Atom(")"),
+					},
+				},
+				Juxtaposition{Atom("   AND"), Atom("y"), Atom("NOT"), Atom("BETWEEN"), Atom("1"), Atom("AND"), Atom("10")},
+				Juxtaposition{Atom("   AND"), Atom("z"), Atom("LIKE"), Atom("'a%'"), Atom("ESCAPE"), Atom("'!'")},
+				Juxtaposition{Atom("    OR"), Atom("w"), Atom("SIMILAR"), Atom("TO"), Atom("'b%'")},
+				Juxtaposition{Atom("   AND"), Atom("v"), Atom("IS"), Atom("NOT"), Atom("NULL")},
+				Juxtaposition{Atom("   AND"), Atom("u"), Atom("IS"), Atom("DISTINCT"), Atom("FROM"), Atom("x")},
+				Juxtaposition{Atom("   AND"), Atom("flag"), Atom("IS"), Atom("NOT"), Atom("TRUE")},
+			},
+			Atom(";"),
+		}, l)
+	})
+
+	t.Run("case", func(t *testing.T) {
+		p := NewParser(`SELECT CASE st WHEN 1, 2 THEN 'low' ELSE 'high' END, COALESCE(x, y, 0) FROM t;`)
+		l, err := p.DirectSQLStatement()
+		assert.NoError(t, err)
+
+		when := Juxtaposition{
+			Atom("WHEN"),
+			Concatenation{Atom("1"), Atom(",")},
+			Atom("2"),
+			Atom("THEN"),
+			Atom("'low'"),
+		}
+		assert.Equal(t, Concatenation{
+			Stack{
+				Juxtaposition{
+					Atom("SELECT"),
+					Concatenation{
+						Choice{
+							One: Juxtaposition{
+								Atom("CASE"),
+								Atom("st"),
+								Atom("WHEN"),
+								Concatenation{Atom("1"), Atom(",")},
+								Atom("2"),
+								Atom("THEN"),
+								Atom("'low'"),
+								Atom("ELSE"),
+								Atom("'high'"),
+								Atom("END"),
+							},
+							Another: Stack{
+								Juxtaposition{
+									Atom("CASE"),
+									Atom("st"),
+									Stack{when, Juxtaposition{Atom("ELSE"), Atom("'high'")}},
+								},
+								Atom("END"),
+							},
+						},
+						Atom(","),
+					},
+					Concatenation{
+						Atom("COALESCE"),
+						Atom("("),
+						Juxtaposition{
+							Concatenation{Atom("x"), Atom(",")},
+							Concatenation{Atom("y"), Atom(",")},
+							Atom("0"),
+						},
+						Atom(")"),
+					},
+				},
+				Juxtaposition{Atom("  FROM"), Atom("t")},
+			},
+			Atom(";"),
+		}, l)
+
+		p = NewParser(`SELECT CASE WHEN (aa + bb) > 0 THEN 1 END FROM t;`)
+		l, err = p.DirectSQLStatement()
+		assert.NoError(t, err)
+
+		sum := Concatenation{
+			Atom("("),
+			Choice{
+				One:     Juxtaposition{Atom("aa"), Atom("+"), Atom("bb")},
+				Another: Stack{Atom("aa"), Juxtaposition{Atom("+"), Atom("bb")}},
+			},
+			Atom(")"),
+		}
+		assert.Equal(t, Concatenation{
+			Stack{
+				Juxtaposition{
+					Atom("SELECT"),
+					Choice{
+						One: Juxtaposition{Atom("CASE"), Atom("WHEN"), sum, Atom(">"), Atom("0"), Atom("THEN"), Atom("1"), Atom("END")},
+						Another: Stack{
+							Juxtaposition{
+								Atom("CASE"),
+								Stack{Juxtaposition{Atom("WHEN"), sum, Atom(">"), Atom("0"), Atom("THEN"), Atom("1")}},
+							},
+							Atom("END"),
+						},
+					},
+				},
+				Juxtaposition{Atom("  FROM"), Atom("t")},
+			},
+			Atom(";"),
+		}, l)
+	})
+
+	t.Run("functions", func(t *testing.T) {
+		p := NewParser(`SELECT lower(nm), myFunc(), CAST(x AS character varying(255)), EXTRACT(YEAR FROM d) FROM t;`)
+		l, err := p.DirectSQLStatement()
+		assert.NoError(t, err)
+		assert.Equal(t, Concatenation{
+			Stack{
+				Juxtaposition{
+					Atom("SELECT"),
+					Concatenation{
+						Concatenation{Atom("LOWER"), Atom("("), Atom("nm"), Atom(")")},
+						Atom(","),
+					},
+					Concatenation{
+						Concatenation{Atom("myFunc"), Atom("("), Atom(")")},
+						Atom(","),
+					},
+					Concatenation{
+						Concatenation{
+							Atom("CAST"),
+							Atom("("),
+							Juxtaposition{
+								Atom("x"),
+								Atom("AS"),
+								Concatenation{
+									Juxtaposition{Atom("CHARACTER"), Atom("VARYING")},
+									Atom("("),
+									Atom("255"),
+									Atom(")"),
+								},
+							},
+							Atom(")"),
+						},
+						Atom(","),
+					},
+					Concatenation{
+						Atom("EXTRACT"),
+						Atom("("),
+						Juxtaposition{Atom("YEAR"), Atom("FROM"), Atom("d")},
+						Atom(")"),
+					},
+				},
+				Juxtaposition{Atom("  FROM"), Atom("t")},
+			},
+			Atom(";"),
+		}, l)
+	})
+
+	t.Run("aggregates", func(t *testing.T) {
+		p := NewParser(`SELECT DISTINCT COUNT(*), SUM(DISTINCT x) FILTER (WHERE x > 0), PERCENTILE_CONT(0.5) WITHIN GROUP (ORDER BY x) FROM t;`)
+		l, err := p.DirectSQLStatement()
+		assert.NoError(t, err)
+		assert.Equal(t, Concatenation{
+			Stack{
+				Juxtaposition{
+					Atom("SELECT"),
+					Atom("DISTINCT"),
+					Concatenation{
+						Concatenation{Atom("COUNT"), Atom("("), Atom("*"), Atom(")")},
+						Atom(","),
+					},
+					Concatenation{
+						Juxtaposition{
+							Concatenation{
+								Atom("SUM"),
+								Atom("("),
+								Juxtaposition{Atom("DISTINCT"), Atom("x")},
+								Atom(")"),
+							},
+							Atom("FILTER"),
+							Concatenation{
+								Atom("("),
+								Juxtaposition{Atom("WHERE"), Atom("x"), Atom(">"), Atom("0")},
+								Atom(")"),
+							},
+						},
+						Atom(","),
+					},
+					Concatenation{Atom("PERCENTILE_CONT"), Atom("("), Atom("0.5"), Atom(")")},
+					Atom("WITHIN"),
+					Atom("GROUP"),
+					Concatenation{
+						Atom("("),
+						Juxtaposition{Atom("ORDER"), Atom("BY"), Atom("x")},
+						Atom(")"),
+					},
+				},
+				Juxtaposition{Atom("  FROM"), Atom("t")},
+			},
+			Atom(";"),
+		}, l)
+	})
+
+	t.Run("window", func(t *testing.T) {
+		p := NewParser(`SELECT ROW_NUMBER() OVER (PARTITION BY x ORDER BY y ROWS UNBOUNDED PRECEDING), SUM(y) OVER w FROM t WINDOW w AS (ORDER BY y);`)
+		l, err := p.DirectSQLStatement()
+		assert.NoError(t, err)
+		assert.Equal(t, Concatenation{
+			Stack{
+				Juxtaposition{
+					Atom("SELECT"),
+					Concatenation{
+						Juxtaposition{
+							Concatenation{Atom("ROW_NUMBER"), Atom("("), Atom(")")},
+							Atom("OVER"),
+							Choice{
+								One: Concatenation{
+									Atom("("),
+									Juxtaposition{
+										Atom("PARTITION"), Atom("BY"), Atom("x"),
+										Atom("ORDER"), Atom("BY"), Atom("y"),
+										Atom("ROWS"), Atom("UNBOUNDED"), Atom("PRECEDING"),
+									},
+									Atom(")"),
+								},
+								Another: Concatenation{
+									Atom("("),
+									Stack{
+										Juxtaposition{Atom("PARTITION"), Atom("BY"), Atom("x")},
+										Juxtaposition{Atom("    ORDER"), Atom("BY"), Atom("y")},
+										Juxtaposition{Atom("     ROWS"), Atom("UNBOUNDED"), Atom("PRECEDING")},
+									},
+									Atom(")"),
+								},
+							},
+						},
+						Atom(","),
+					},
+					Concatenation{Atom("SUM"), Atom("("), Atom("y"), Atom(")")},
+					Atom("OVER"),
+					Atom("w"),
+				},
+				Juxtaposition{Atom("  FROM"), Atom("t")},
+				Juxtaposition{
+					Atom("WINDOW"),
+					Atom("w"),
+					Atom("AS"),
+					Choice{
+						One: Concatenation{
+							Atom("("),
+							Juxtaposition{Atom("ORDER"), Atom("BY"), Atom("y")},
+							Atom(")"),
+						},
+						Another: Concatenation{
+							Atom("("),
+							Stack{Juxtaposition{Atom("ORDER"), Atom("BY"), Atom("y")}},
+							Atom(")"),
+						},
+					},
+				},
+			},
+			Atom(";"),
+		}, l)
+
+		p = NewParser(`SELECT RANK() OVER (ORDER BY x) FROM t;`)
+		l, err = p.DirectSQLStatement()
+		assert.NoError(t, err)
+		assert.Equal(t, Concatenation{
+			Stack{
+				Juxtaposition{
+					Atom("SELECT"),
+					Concatenation{Atom("RANK"), Atom("("), Atom(")")},
+					Atom("OVER"),
+					Choice{
+						One:     Concatenation{Atom("("), Juxtaposition{Atom("ORDER"), Atom("BY"), Atom("x")}, Atom(")")},
+						Another: Concatenation{Atom("("), Stack{Juxtaposition{Atom("ORDER"), Atom("BY"), Atom("x")}}, Atom(")")},
+					},
+				},
+				Juxtaposition{Atom("  FROM"), Atom("t")},
+			},
+			Atom(";"),
+		}, l)
+	})
+
+	t.Run("with", func(t *testing.T) {
+		p := NewParser(`WITH RECURSIVE r (x) AS (SELECT x FROM t), q AS (SELECT y FROM u) SELECT x FROM r;`)
+		l, err := p.DirectSQLStatement()
+		assert.NoError(t, err)
+		assert.Equal(t, Concatenation{
+			Stack{
+				Juxtaposition{
+					Atom("  WITH"),
+					Atom("RECURSIVE"),
+					Stack{
+						Concatenation{
+							Stack{
+								Juxtaposition{
+									Atom("r"),
+									Concatenation{Atom("("), Juxtaposition{Atom("x")}, Atom(")")},
+									Atom("AS"),
+									Atom("("),
+								},
+								Indent{Stack{
+									Juxtaposition{Atom("SELECT"), Atom("x")},
+									Juxtaposition{Atom("  FROM"), Atom("t")},
+								}},
+								Atom(")"),
+							},
+							Atom(","),
+						},
+						Stack{
+							Juxtaposition{Atom("q"), Atom("AS"), Atom("(")},
+							Indent{Stack{
+								Juxtaposition{Atom("SELECT"), Atom("y")},
+								Juxtaposition{Atom("  FROM"), Atom("u")},
+							}},
+							Atom(")"),
+						},
+					},
+				},
+				Juxtaposition{Atom("SELECT"), Atom("x")},
+				Juxtaposition{Atom("  FROM"), Atom("r")},
+			},
+			Atom(";"),
+		}, l)
+	})
+
+	t.Run("intersect", func(t *testing.T) {
+		p := NewParser(`SELECT x FROM t INTERSECT ALL SELECT y FROM u UNION SELECT z FROM v;`)
+
+		l, err := p.DirectSQLStatement()
+		assert.NoError(t, err)
+		assert.Equal(t, Concatenation{
+			Stack{
+				Indent{Stack{
+					Juxtaposition{Atom("   SELECT"), Atom("x")},
+					Juxtaposition{Atom("     FROM"), Atom("t")},
+					Juxtaposition{Atom("INTERSECT"), Atom("ALL")},
+					Juxtaposition{Atom("   SELECT"), Atom("y")},
+					Juxtaposition{Atom("     FROM"), Atom("u")},
+				}},
+				Juxtaposition{Atom(" UNION")},
+				Juxtaposition{Atom("SELECT"), Atom("z")},
+				Juxtaposition{Atom("  FROM"), Atom("v")},
+			},
+			Atom(";"),
+		}, l)
+
+		p = NewParser(`SELECT x FROM t UNION SELECT y FROM u INTERSECT SELECT z FROM v;`)
+
+		l, err = p.DirectSQLStatement()
+		assert.NoError(t, err)
+		assert.Equal(t, Concatenation{
+			Stack{
+				Juxtaposition{Atom("SELECT"), Atom("x")},
+				Juxtaposition{Atom("  FROM"), Atom("t")},
+				Juxtaposition{Atom(" UNION")},
+				Indent{Stack{
+					Juxtaposition{Atom("   SELECT"), Atom("y")},
+					Juxtaposition{Atom("     FROM"), Atom("u")},
+					Juxtaposition{Atom("INTERSECT")},
+					Juxtaposition{Atom("   SELECT"), Atom("z")},
+					Juxtaposition{Atom("     FROM"), Atom("v")},
+				}},
+			},
+			Atom(";"),
+		}, l)
+
+		p = NewParser(`SELECT x FROM t INTERSECT SELECT y FROM u;`)
+
+		l, err = p.DirectSQLStatement()
+		assert.NoError(t, err)
+		assert.Equal(t, Concatenation{
+			Stack{
+				Juxtaposition{Atom("   SELECT"), Atom("x")},
+				Juxtaposition{Atom("     FROM"), Atom("t")},
+				Juxtaposition{Atom("INTERSECT")},
+				Juxtaposition{Atom("   SELECT"), Atom("y")},
+				Juxtaposition{Atom("     FROM"), Atom("u")},
+			},
+			Atom(";"),
+		}, l)
+	})
+
+	t.Run("values", func(t *testing.T) {
+		p := NewParser(`VALUES (1, 'a'), (22, 'b') UNION TABLE t;`)
+
+		l, err := p.DirectSQLStatement()
+		assert.NoError(t, err)
+		assert.Equal(t, Concatenation{
+			Stack{
+				Juxtaposition{
+					Atom("VALUES"),
+					Choice{
+						One: Stack{
+							Concatenation{
+								Concatenation{
+									Atom("("),
+									Juxtaposition{
+										Concatenation{Concatenation{Atom("1"), Atom(",")}, Atom(" ")},
+										Atom("'a'"),
+									},
+									Atom(")"),
+								},
+								Atom(","),
+							},
+							Concatenation{
+								Atom("("),
+								Juxtaposition{Concatenation{Atom("22"), Atom(",")}, Atom("'b'")},
+								Atom(")"),
+							},
+						},
+						Another: Stack{
+							Concatenation{
+								Concatenation{
+									Atom("("),
+									Juxtaposition{Concatenation{Atom("1"), Atom(",")}, Atom("'a'")},
+									Atom(")"),
+								},
+								Atom(","),
+							},
+							Concatenation{
+								Atom("("),
+								Juxtaposition{Concatenation{Atom("22"), Atom(",")}, Atom("'b'")},
+								Atom(")"),
+							},
+						},
+					},
+				},
+				Juxtaposition{Atom(" UNION")},
+				Juxtaposition{Atom(" TABLE"), Atom("t")},
+			},
+			Atom(";"),
+		}, l)
+	})
+
+	t.Run("insert", func(t *testing.T) {
+		p := NewParser(`INSERT INTO t (x, y) SELECT x, y FROM u;`)
+
+		l, err := p.DirectSQLStatement()
+		assert.NoError(t, err)
+		assert.Equal(t, Concatenation{
+			Stack{
+				Juxtaposition{
+					Atom("INSERT"),
+					Atom("INTO"),
+					Atom("t"),
+					Choice{
+						One: Concatenation{
+							Atom("("),
+							Juxtaposition{Concatenation{Atom("x"), Atom(",")}, Atom("y")},
+							Atom(")"),
+						},
+						Another: Concatenation{
+							Atom("("),
+							Stack{Concatenation{Atom("x"), Atom(",")}, Atom("y")},
+							Atom(")"),
+						},
+					},
+				},
+				Juxtaposition{Atom("SELECT"), Concatenation{Atom("x"), Atom(",")}, Atom("y")},
+				Juxtaposition{Atom("  FROM"), Atom("u")},
+			},
+			Atom(";"),
+		}, l)
+
+		p = NewParser(`INSERT INTO t DEFAULT VALUES;`)
+
+		l, err = p.DirectSQLStatement()
+		assert.NoError(t, err)
+		assert.Equal(t, Concatenation{
+			Juxtaposition{Atom("INSERT"), Atom("INTO"), Atom("t"), Atom("DEFAULT"), Atom("VALUES")},
+			Atom(";"),
+		}, l)
+
+		p = NewParser(`INSERT INTO t (SELECT xx FROM u);`)
+
+		l, err = p.DirectSQLStatement()
+		assert.NoError(t, err)
+		assert.Equal(t, Concatenation{
+			Stack{
+				Juxtaposition{Atom("INSERT"), Atom("INTO"), Atom("t")},
+				Indent{Stack{
+					Concatenation{
+						Atom("("),
+						Stack{
+							Juxtaposition{Atom("SELECT"), Atom("xx")},
+							Juxtaposition{Atom("  FROM"), Atom("u")},
+						},
+						Atom(")"),
+					},
+				}},
+			},
+			Atom(";"),
+		}, l)
+
+		p = NewParser(`INSERT INTO t (aa, bb) VALUES (DEFAULT, 1);`)
+
+		l, err = p.DirectSQLStatement()
+		assert.NoError(t, err)
+		assert.Equal(t, Concatenation{
+			Stack{
+				Juxtaposition{
+					Atom("INSERT"),
+					Atom("INTO"),
+					Atom("t"),
+					Choice{
+						One: Concatenation{
+							Atom("("),
+							Juxtaposition{Concatenation{Atom("aa"), Atom(",")}, Atom("bb")},
+							Atom(")"),
+						},
+						Another: Concatenation{
+							Atom("("),
+							Stack{Concatenation{Atom("aa"), Atom(",")}, Atom("bb")},
+							Atom(")"),
+						},
+					},
+				},
+				Juxtaposition{
+					Atom("VALUES"),
+					Stack{
+						Concatenation{
+							Atom("("),
+							Juxtaposition{Concatenation{Atom("DEFAULT"), Atom(",")}, Atom("1")},
+							Atom(")"),
+						},
+					},
+				},
+			},
+			Atom(";"),
+		}, l)
+	})
+
+	t.Run("update", func(t *testing.T) {
+		p := NewParser(`UPDATE t AS x SET y = 1, z = DEFAULT WHERE CURRENT OF cur;`)
+
+		l, err := p.DirectSQLStatement()
+		assert.NoError(t, err)
+		assert.Equal(t, Concatenation{
+			Stack{
+				Juxtaposition{Atom("UPDATE"), Atom("t"), Atom("AS"), Atom("x")},
+				Juxtaposition{
+					Atom("   SET"),
+					Stack{
+						Concatenation{Juxtaposition{Atom("y"), Atom("="), Atom("1")}, Atom(",")},
+						Juxtaposition{Atom("z"), Atom("="), Atom("DEFAULT")},
+					},
+				},
+				Juxtaposition{Atom(" WHERE"), Atom("CURRENT"), Atom("OF"), Atom("cur")},
+			},
+			Atom(";"),
+		}, l)
+	})
+
+	t.Run("delete", func(t *testing.T) {
+		p := NewParser(`DELETE FROM t x WHERE x.y = 1;`)
+
+		l, err := p.DirectSQLStatement()
+		assert.NoError(t, err)
+		assert.Equal(t, Concatenation{
+			Stack{
+				Juxtaposition{Atom("DELETE"), Atom("FROM"), Atom("t"), Atom("x")},
+				Juxtaposition{
+					Atom(" WHERE"),
+					Concatenation{Atom("x"), Atom("."), Atom("y")},
+					Atom("="),
+					Atom("1"),
+				},
+			},
+			Atom(";"),
+		}, l)
+
+		p = NewParser(`DELETE FROM t;`)
+
+		l, err = p.DirectSQLStatement()
+		assert.NoError(t, err)
+		assert.Equal(t, Concatenation{
+			Juxtaposition{Atom("DELETE"), Atom("FROM"), Atom("t")},
+			Atom(";"),
+		}, l)
+	})
+
+	t.Run("merge", func(t *testing.T) {
+		p := NewParser(`MERGE INTO t USING u ON t.id = u.id WHEN MATCHED THEN UPDATE SET x = u.x WHEN NOT MATCHED THEN INSERT VALUES (u.id, u.x);`)
+
+		l, err := p.DirectSQLStatement()
+		assert.NoError(t, err)
+		assert.Equal(t, Concatenation{
+			Stack{
+				Juxtaposition{Atom("MERGE"), Atom("INTO"), Atom("t")},
+				Juxtaposition{Atom("USING"), Atom("u")},
+				Juxtaposition{
+					Atom("   ON"),
+					Concatenation{Atom("t"), Atom("."), Atom("id")},
+					Atom("="),
+					Concatenation{Atom("u"), Atom("."), Atom("id")},
+				},
+				Juxtaposition{Atom(" WHEN"), Atom("MATCHED"), Atom("THEN")},
+				Indent{
+					Stack{
+						Juxtaposition{Atom("UPDATE")},
+						Juxtaposition{Atom("   SET"), Atom("x"), Atom("="), Concatenation{Atom("u"), Atom("."), Atom("x")}},
+					},
+				},
+				Juxtaposition{Atom(" WHEN"), Atom("NOT"), Atom("MATCHED"), Atom("THEN")},
+				Indent{
+					Stack{
+						Juxtaposition{Atom("INSERT")},
+						Juxtaposition{
+							Atom("VALUES"),
+							Stack{
+								Concatenation{
+									Atom("("),
+									Juxtaposition{
+										Concatenation{Concatenation{Atom("u"), Atom("."), Atom("id")}, Atom(",")},
+										Concatenation{Atom("u"), Atom("."), Atom("x")},
+									},
+									Atom(")"),
+								},
+							},
+						},
+					},
+				},
+			},
+			Atom(";"),
+		}, l)
+	})
+
+	t.Run("create table", func(t *testing.T) {
+		p := NewParser(`CREATE TABLE dept (deptno INTEGER PRIMARY KEY, dname TEXT, CHECK (deptno > 0));`)
+
+		l, err := p.DirectSQLStatement()
+		assert.NoError(t, err)
+
+		elements := Stack{
+			Concatenation{
+				Juxtaposition{Atom("deptno"), Atom("INTEGER"), Juxtaposition{Atom("PRIMARY"), Atom("KEY")}},
+				Atom(","),
+			},
+			Concatenation{
+				Juxtaposition{Concatenation{Atom("dname"), Atom(" ")}, Atom("TEXT")},
+				Atom(","),
+			},
+			Juxtaposition{
+				Atom("CHECK"),
+				Concatenation{Atom("("), Juxtaposition{Atom("deptno"), Atom(">"), Atom("0")}, Atom(")")},
+			},
+		}
+		assert.Equal(t, Concatenation{
+			Stack{
+				Juxtaposition{Atom("CREATE"), Atom("TABLE"), Atom("dept"), Atom("(")},
+				Indent{elements},
+				Atom(")"),
+			},
+			Atom(";"),
+		}, l)
+
+		p = NewParser(`CREATE TABLE dept (deptno INTEGER);`)
+
+		l, err = p.DirectSQLStatement()
+		assert.NoError(t, err)
+
+		element := Juxtaposition{Atom("deptno"), Atom("INTEGER")}
+		assert.Equal(t, Concatenation{
+			Choice{
+				One: Juxtaposition{
+					Atom("CREATE"),
+					Atom("TABLE"),
+					Atom("dept"),
+					Concatenation{Atom("("), element, Atom(")")},
+				},
+				Another: Stack{
+					Juxtaposition{Atom("CREATE"), Atom("TABLE"), Atom("dept"), Atom("(")},
+					Indent{element},
+					Atom(")"),
+				},
+			},
+			Atom(";"),
+		}, l)
+
+		p = NewParser(`CREATE TABLE tt (id INTEGER PRIMARY KEY NOT NULL, nn INTEGER CHECK (nn > 0) NOT NULL, qq INTEGER NOT NULL DEFAULT 0);`)
+
+		l, err = p.DirectSQLStatement()
+		assert.NoError(t, err)
+		assert.Equal(t, Concatenation{
+			Stack{
+				Juxtaposition{Atom("CREATE"), Atom("TABLE"), Atom("tt"), Atom("(")},
+				Indent{Stack{
+					Concatenation{
+						Juxtaposition{Atom("id"), Atom("INTEGER"), Juxtaposition{Atom("PRIMARY"), Atom("KEY"), Atom("NOT"), Atom("NULL")}},
+						Atom(","),
+					},
+					Concatenation{
+						Juxtaposition{
+							Atom("nn"),
+							Atom("INTEGER"),
+							Juxtaposition{
+								Atom("CHECK"),
+								Concatenation{Atom("("), Juxtaposition{Atom("nn"), Atom(">"), Atom("0")}, Atom(")")},
+								Atom("NOT"),
+								Atom("NULL"),
+							},
+						},
+						Atom(","),
+					},
+					Juxtaposition{Atom("qq"), Atom("INTEGER"), Juxtaposition{Atom("NOT"), Atom("NULL"), Atom("DEFAULT"), Atom("0")}},
+				}},
+				Atom(")"),
+			},
+			Atom(";"),
+		}, l)
+	})
+
+	t.Run("alter table", func(t *testing.T) {
+		p := NewParser(`ALTER TABLE t ADD COLUMN x INTEGER NOT NULL, ALTER COLUMN y SET DEFAULT 0;`)
+
+		l, err := p.DirectSQLStatement()
+		assert.NoError(t, err)
+		assert.Equal(t, Concatenation{
+			Stack{
+				Juxtaposition{Atom("ALTER"), Atom("TABLE"), Atom("t")},
+				Indent{
+					Stack{
+						Concatenation{
+							Juxtaposition{
+								Atom("ADD"),
+								Atom("COLUMN"),
+								Atom("x"),
+								Atom("INTEGER"),
+								Juxtaposition{Atom("NOT"), Atom("NULL")},
+							},
+							Atom(","),
+						},
+						Juxtaposition{Atom("ALTER"), Atom("COLUMN"), Atom("y"), Atom("SET"), Atom("DEFAULT"), Atom("0")},
+					},
+				},
+			},
+			Atom(";"),
+		}, l)
+
+		p = NewParser(`ALTER TABLE t ALTER COLUMN y DROP DEFAULT, ADD COLUMN IF NOT EXISTS z INTEGER;`)
+
+		l, err = p.DirectSQLStatement()
+		assert.NoError(t, err)
+		assert.Equal(t, Concatenation{
+			Stack{
+				Juxtaposition{Atom("ALTER"), Atom("TABLE"), Atom("t")},
+				Indent{
+					Stack{
+						Concatenation{
+							Juxtaposition{Atom("ALTER"), Atom("COLUMN"), Atom("y"), Atom("DROP"), Atom("DEFAULT")},
+							Atom(","),
+						},
+						Juxtaposition{Atom("ADD"), Atom("COLUMN"), Atom("IF"), Atom("NOT"), Atom("EXISTS"), Atom("z"), Atom("INTEGER")},
+					},
+				},
+			},
+			Atom(";"),
+		}, l)
+	})
+
+	t.Run("drop table", func(t *testing.T) {
+		p := NewParser(`DROP TABLE IF EXISTS t, u CASCADE;`)
+
+		l, err := p.DirectSQLStatement()
+		assert.NoError(t, err)
+		assert.Equal(t, Concatenation{
+			Juxtaposition{
+				Atom("DROP"),
+				Atom("TABLE"),
+				Atom("IF"),
+				Atom("EXISTS"),
+				Concatenation{Atom("t"), Atom(",")},
+				Atom("u"),
+				Atom("CASCADE"),
+			},
+			Atom(";"),
+		}, l)
+	})
+
+	t.Run("truncate", func(t *testing.T) {
+		p := NewParser(`TRUNCATE TABLE t RESTART IDENTITY;`)
+
+		l, err := p.DirectSQLStatement()
+		assert.NoError(t, err)
+		assert.Equal(t, Concatenation{
+			Juxtaposition{Atom("TRUNCATE"), Atom("TABLE"), Atom("t"), Atom("RESTART"), Atom("IDENTITY")},
+			Atom(";"),
+		}, l)
+	})
+
+	t.Run("create view", func(t *testing.T) {
+		p := NewParser(`CREATE OR REPLACE VIEW v (x) AS SELECT y FROM t WITH CHECK OPTION;`)
+
+		l, err := p.DirectSQLStatement()
+		assert.NoError(t, err)
+		assert.Equal(t, Concatenation{
+			Stack{
+				Juxtaposition{
+					Atom("CREATE"),
+					Atom("OR"),
+					Atom("REPLACE"),
+					Atom("VIEW"),
+					Atom("v"),
+					Concatenation{Atom("("), Juxtaposition{Atom("x")}, Atom(")")},
+					Atom("AS"),
+				},
+				Indent{
+					Stack{
+						Juxtaposition{Atom("SELECT"), Atom("y")},
+						Juxtaposition{Atom("  FROM"), Atom("t")},
+					},
+				},
+				Juxtaposition{Atom("WITH"), Atom("CHECK"), Atom("OPTION")},
+			},
+			Atom(";"),
+		}, l)
+	})
+
+	t.Run("create index", func(t *testing.T) {
+		p := NewParser(`CREATE INDEX ix ON t (x) WHERE y;`)
+
+		l, err := p.DirectSQLStatement()
+		assert.NoError(t, err)
+		assert.Equal(t, Concatenation{
+			Choice{
+				One: Stack{
+					Juxtaposition{
+						Atom("CREATE"),
+						Atom("INDEX"),
+						Atom("ix"),
+						Atom("ON"),
+						Atom("t"),
+						Concatenation{Atom("("), Juxtaposition{Atom("x")}, Atom(")")},
+					},
+					Juxtaposition{Atom(" WHERE"), Atom("y")},
+				},
+				Another: Stack{
+					Juxtaposition{Atom("CREATE"), Atom("INDEX"), Atom("ix")},
+					Juxtaposition{
+						Atom("    ON"),
+						Atom("t"),
+						Concatenation{Atom("("), Juxtaposition{Atom("x")}, Atom(")")},
+					},
+					Juxtaposition{Atom(" WHERE"), Atom("y")},
+				},
+			},
+			Atom(";"),
+		}, l)
+	})
+
+	t.Run("create sequence", func(t *testing.T) {
+		p := NewParser(`CREATE SEQUENCE s START WITH 1 INCREMENT BY -1;`)
+
+		l, err := p.DirectSQLStatement()
+		assert.NoError(t, err)
+		assert.Equal(t, Concatenation{
+			Choice{
+				One: Juxtaposition{
+					Atom("CREATE"),
+					Atom("SEQUENCE"),
+					Atom("s"),
+					Atom("START"),
+					Atom("WITH"),
+					Atom("1"),
+					Atom("INCREMENT"),
+					Atom("BY"),
+					Concatenation{Atom("-"), Atom("1")},
+				},
+				Another: Stack{
+					Juxtaposition{Atom("CREATE"), Atom("SEQUENCE"), Atom("s")},
+					Indent{
+						Stack{
+							Juxtaposition{Atom("START"), Atom("WITH"), Atom("1")},
+							Juxtaposition{Atom("INCREMENT"), Atom("BY"), Concatenation{Atom("-"), Atom("1")}},
+						},
+					},
+				},
+			},
+			Atom(";"),
+		}, l)
+	})
+
+	t.Run("create schema", func(t *testing.T) {
+		p := NewParser(`CREATE SCHEMA IF NOT EXISTS reporting AUTHORIZATION alice;`)
+
+		l, err := p.DirectSQLStatement()
+		assert.NoError(t, err)
+		assert.Equal(t, Concatenation{
+			Juxtaposition{
+				Atom("CREATE"),
+				Atom("SCHEMA"),
+				Atom("IF"),
+				Atom("NOT"),
+				Atom("EXISTS"),
+				Atom("reporting"),
+				Atom("AUTHORIZATION"),
+				Atom("alice"),
+			},
+			Atom(";"),
+		}, l)
+	})
+
+	t.Run("grant", func(t *testing.T) {
+		p := NewParser(`GRANT SELECT, INSERT ON TABLE t TO r WITH GRANT OPTION;`)
+
+		l, err := p.DirectSQLStatement()
+		assert.NoError(t, err)
+		assert.Equal(t, Concatenation{
+			Choice{
+				One: Juxtaposition{
+					Atom("GRANT"),
+					Concatenation{Atom("SELECT"), Atom(",")},
+					Atom("INSERT"),
+					Atom("ON"),
+					Atom("TABLE"),
+					Atom("t"),
+					Atom("TO"),
+					Atom("r"),
+					Atom("WITH"),
+					Atom("GRANT"),
+					Atom("OPTION"),
+				},
+				Another: Stack{
+					Juxtaposition{
+						Atom("GRANT"),
+						Choice{
+							One:     Juxtaposition{Concatenation{Atom("SELECT"), Atom(",")}, Atom("INSERT")},
+							Another: Stack{Concatenation{Atom("SELECT"), Atom(",")}, Atom("INSERT")},
+						},
+					},
+					Juxtaposition{Atom("   ON"), Atom("TABLE"), Atom("t")},
+					Juxtaposition{
+						Atom("   TO"),
+						Choice{One: Juxtaposition{Atom("r")}, Another: Stack{Atom("r")}},
+					},
+					Juxtaposition{Atom(" WITH"), Atom("GRANT"), Atom("OPTION")},
+				},
+			},
+			Atom(";"),
+		}, l)
+
+		p = NewParser(`GRANT SELECT ON ALL TABLES IN SCHEMA public TO rr;`)
+
+		l, err = p.DirectSQLStatement()
+		assert.NoError(t, err)
+		assert.Equal(t, Concatenation{
+			Choice{
+				One: Juxtaposition{
+					Atom("GRANT"),
+					Atom("SELECT"),
+					Atom("ON"),
+					Atom("ALL"),
+					Atom("TABLES"),
+					Atom("IN"),
+					Atom("SCHEMA"),
+					Atom("PUBLIC"),
+					Atom("TO"),
+					Atom("rr"),
+				},
+				Another: Stack{
+					Juxtaposition{Atom("GRANT"), Choice{One: Juxtaposition{Atom("SELECT")}, Another: Stack{Atom("SELECT")}}},
+					Juxtaposition{Atom("   ON"), Atom("ALL"), Atom("TABLES"), Atom("IN"), Atom("SCHEMA"), Atom("PUBLIC")},
+					Juxtaposition{Atom("   TO"), Choice{One: Juxtaposition{Atom("rr")}, Another: Stack{Atom("rr")}}},
+				},
+			},
+			Atom(";"),
+		}, l)
+
+		p = NewParser(`GRANT SELECT ON TABLE tt TO;`)
+
+		_, err = p.DirectSQLStatement()
+		if assert.Error(t, err) {
+			assert.Contains(t, err.Error(), "grant privilege statement: grantee list")
+		}
+	})
+
+	t.Run("alter default privileges", func(t *testing.T) {
+		p := NewParser(`ALTER DEFAULT PRIVILEGES IN SCHEMA s REVOKE ALL ON TABLES FROM PUBLIC CASCADE;`)
+
+		l, err := p.DirectSQLStatement()
+		assert.NoError(t, err)
+		assert.Equal(t, Concatenation{
+			Stack{
+				Juxtaposition{Atom("ALTER"), Atom("DEFAULT"), Atom("PRIVILEGES"), Atom("IN"), Atom("SCHEMA"), Atom("s")},
+				Indent{
+					Choice{
+						One: Juxtaposition{
+							Atom("REVOKE"),
+							Choice{One: Juxtaposition{Atom("ALL")}, Another: Juxtaposition{Atom("ALL")}},
+							Atom("ON"),
+							Atom("TABLES"),
+							Atom("FROM"),
+							Atom("PUBLIC"),
+							Atom("CASCADE"),
+						},
+						Another: Stack{
+							Juxtaposition{
+								Atom("REVOKE"),
+								Choice{One: Juxtaposition{Atom("ALL")}, Another: Juxtaposition{Atom("ALL")}},
+							},
+							Juxtaposition{Atom("    ON"), Atom("TABLES")},
+							Juxtaposition{
+								Atom("  FROM"),
+								Choice{One: Juxtaposition{Atom("PUBLIC")}, Another: Stack{Atom("PUBLIC")}},
+								Atom("CASCADE"),
+							},
+						},
+					},
+				},
+			},
+			Atom(";"),
+		}, l)
+	})
+
+	t.Run("create role", func(t *testing.T) {
+		p := NewParser(`CREATE ROLE r LOGIN;`)
+
+		l, err := p.DirectSQLStatement()
+		assert.NoError(t, err)
+		assert.Equal(t, Concatenation{
+			Choice{
+				One: Juxtaposition{Atom("CREATE"), Atom("ROLE"), Atom("r"), Atom("LOGIN")},
+				Another: Stack{
+					Juxtaposition{Atom("CREATE"), Atom("ROLE"), Atom("r")},
+					Indent{Stack{Atom("LOGIN")}},
+				},
+			},
+			Atom(";"),
+		}, l)
+	})
+
+	t.Run("transaction", func(t *testing.T) {
+		p := NewParser(`START TRANSACTION ISOLATION LEVEL READ COMMITTED, READ WRITE;`)
+
+		l, err := p.DirectSQLStatement()
+		assert.NoError(t, err)
+		assert.Equal(t, Concatenation{
+			Juxtaposition{
+				Atom("START"),
+				Atom("TRANSACTION"),
+				Concatenation{
+					Juxtaposition{Atom("ISOLATION"), Atom("LEVEL"), Atom("READ"), Atom("COMMITTED")},
+					Atom(","),
+				},
+				Atom("READ"),
+				Atom("WRITE"),
+			},
+			Atom(";"),
+		}, l)
+
+		p = NewParser(`ROLLBACK TO SAVEPOINT sp;`)
+
+		l, err = p.DirectSQLStatement()
+		assert.NoError(t, err)
+		assert.Equal(t, Concatenation{
+			Juxtaposition{Atom("ROLLBACK"), Atom("TO"), Atom("SAVEPOINT"), Atom("sp")},
+			Atom(";"),
+		}, l)
+	})
+
+	t.Run("session", func(t *testing.T) {
+		p := NewParser(`SET LOCAL search_path = s, 'x';`)
+
+		l, err := p.DirectSQLStatement()
+		assert.NoError(t, err)
+		assert.Equal(t, Concatenation{
+			Juxtaposition{
+				Atom("SET"),
+				Atom("LOCAL"),
+				Atom("search_path"),
+				Atom("="),
+				Concatenation{Atom("s"), Atom(",")},
+				Atom("'x'"),
+			},
+			Atom(";"),
+		}, l)
+
+		p = NewParser(`SHOW search_path;`)
+
+		l, err = p.DirectSQLStatement()
+		assert.NoError(t, err)
+		assert.Equal(t, Concatenation{
+			Juxtaposition{Atom("SHOW"), Atom("search_path")},
+			Atom(";"),
+		}, l)
+	})
 }